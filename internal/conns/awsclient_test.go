@@ -1,6 +1,7 @@
 package conns
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -77,3 +78,117 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSClientRegionalARN(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Service   string
+		Resource  string
+		Expected  string
+	}{
+		{
+			Name: "AWS Commercial",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Service:  "test",
+			Resource: "resource/id",
+			Expected: "arn:aws:test:us-west-2:123456789012:resource/id", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "AWS GovCloud (US)",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws-us-gov",
+				Region:    "us-gov-west-1", //lintignore:AWSAT003
+			},
+			Service:  "test",
+			Resource: "resource/id",
+			Expected: "arn:aws-us-gov:test:us-gov-west-1:123456789012:resource/id", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "AWS China",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws-cn",
+				Region:    "cn-northwest-1", //lintignore:AWSAT003
+			},
+			Service:  "test",
+			Resource: "resource:id",
+			Expected: "arn:aws-cn:test:cn-northwest-1:123456789012:resource:id", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.AWSClient.RegionalARN(testCase.Service, testCase.Resource)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientRegionalARN_empty(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		AWSClient     *AWSClient
+		ExpectedError string
+	}{
+		{
+			Name: "empty partition",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			},
+			ExpectedError: "partition is empty",
+		},
+		{
+			Name: "empty region",
+			AWSClient: &AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws",
+			},
+			ExpectedError: "region is empty",
+		},
+		{
+			Name: "empty account ID",
+			AWSClient: &AWSClient{
+				Partition: "aws",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			},
+			ExpectedError: "account ID is empty",
+		},
+		{
+			Name:          "empty client",
+			AWSClient:     &AWSClient{},
+			ExpectedError: "partition is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.AWSClient.RegionalARN("test", "resource/id")
+
+			if err == nil {
+				t.Fatalf("expected error, got ARN %s", got)
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("got error %q, expected it to contain %q", err, testCase.ExpectedError)
+			}
+
+			if got != "" {
+				t.Errorf("got ARN %s, expected none", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package conns
+
+import (
+	"sync"
+	"time"
+)
+
+// listCacheTTL is how long a ListCache serves a result before calling the API again.
+// It is long enough to be shared by the reads of a single refresh, and short enough
+// that changes made outside of Terraform, or through another provider configuration,
+// are picked up by the next refresh of a long-running apply.
+const listCacheTTL = 30 * time.Second
+
+// ListCache memoizes the result of a list call that has no single-item lookup, so that
+// reads of several resources through the same AWSClient can share a single API call.
+// Each AWSClient holds its own caches, so results are never shared between provider
+// configurations. Callers invalidate the cache after changing the listed resources.
+//
+// The zero value is an empty cache.
+type ListCache struct {
+	lock    sync.Mutex
+	expires time.Time
+	value   interface{}
+}
+
+// Get returns the cached result, calling list to refresh it when the cache is empty
+// or has expired. Errors are not cached. The result is shared between callers, who
+// must copy it before modifying it.
+func (c *ListCache) Get(list func() (interface{}, error)) (interface{}, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !c.expires.IsZero() && time.Now().Before(c.expires) {
+		return c.value, nil
+	}
+
+	value, err := list()
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.expires = time.Now().Add(listCacheTTL)
+	c.value = value
+
+	return value, nil
+}
+
+// Invalidate empties the cache, so that the next Get calls the API.
+func (c *ListCache) Invalidate() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.expires = time.Time{}
+	c.value = nil
+}
@@ -0,0 +1,45 @@
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestListCache(t *testing.T) {
+	var c ListCache
+	var calls int
+
+	list := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if v, err := c.Get(list); err != nil || v != 1 {
+			t.Fatalf("got %v, %v; expected the first result", v, err)
+		}
+	}
+
+	c.Invalidate()
+
+	if v, _ := c.Get(list); v != 2 {
+		t.Errorf("got %v after invalidating, expected a new result", v)
+	}
+
+	c.expires = time.Now().Add(-time.Second)
+
+	if v, _ := c.Get(list); v != 3 {
+		t.Errorf("got %v after expiring, expected a new result", v)
+	}
+
+	c.Invalidate()
+
+	if _, err := c.Get(func() (interface{}, error) { return nil, errors.New("test") }); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if v, _ := c.Get(list); v != 4 {
+		t.Errorf("got %v after an error, expected errors not to be cached", v)
+	}
+}
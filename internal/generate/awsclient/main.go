@@ -131,6 +131,7 @@ import (
 {{ range .Services }}
 	"github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
 {{- end }}
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
@@ -145,6 +146,7 @@ type AWSClient struct {
 	Region                    string
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
+	SESReceiptFilters         ListCache
 	Session                   *session.Session
 	SupportedPlatforms        []string
 	TerraformVersion          string
@@ -167,4 +169,27 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// RegionalARN returns an ARN for the specified service and resource in the
+// client's partition, region and account
+// e.g. arn:aws:SERVICE:us-west-2:123456789012:RESOURCE
+// An error is returned instead of a malformed ARN if any of these is unknown.
+func (client *AWSClient) RegionalARN(service, resource string) (string, error) {
+	switch {
+	case client.Partition == "":
+		return "", fmt.Errorf("error building %s ARN for %q: partition is empty", service, resource)
+	case client.Region == "":
+		return "", fmt.Errorf("error building %s ARN for %q: region is empty", service, resource)
+	case client.AccountID == "":
+		return "", fmt.Errorf("error building %s ARN for %q: account ID is empty", service, resource)
+	}
+
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   service,
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  resource,
+	}.String(), nil
+}
 `
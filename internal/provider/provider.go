@@ -411,6 +411,11 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"aws_accessanalyzer_analyzed_resource":    accessanalyzer.DataSourceAnalyzedResource(),
+			"aws_accessanalyzer_analyzers":            accessanalyzer.DataSourceAnalyzers(),
+			"aws_accessanalyzer_archive_rule_preview": accessanalyzer.DataSourceArchiveRulePreview(),
+			"aws_accessanalyzer_finding":              accessanalyzer.DataSourceFinding(),
+
 			"aws_acm_certificate": acm.DataSourceCertificate(),
 
 			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
@@ -544,6 +549,8 @@ func Provider() *schema.Provider {
 			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
 			"aws_ec2_serial_console_access":                  ec2.DataSourceSerialConsoleAccess(),
 			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
+			"aws_ec2_traffic_mirror_filter":                  ec2.DataSourceTrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":             ec2.DataSourceTrafficMirrorFilterRule(),
 			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
 			"aws_ec2_transit_gateway_connect":                ec2.DataSourceTransitGatewayConnect(),
 			"aws_ec2_transit_gateway_connect_peer":           ec2.DataSourceTransitGatewayConnectPeer(),
@@ -772,9 +779,12 @@ func Provider() *schema.Provider {
 
 			"aws_ram_resource_share": ram.DataSourceResourceShare(),
 
-			"aws_ses_active_receipt_rule_set": ses.DataSourceActiveReceiptRuleSet(),
-			"aws_ses_domain_identity":         ses.DataSourceDomainIdentity(),
-			"aws_ses_email_identity":          ses.DataSourceEmailIdentity(),
+			"aws_ses_active_receipt_rule_set":  ses.DataSourceActiveReceiptRuleSet(),
+			"aws_ses_domain_identity":          ses.DataSourceDomainIdentity(),
+			"aws_ses_email_identity":           ses.DataSourceEmailIdentity(),
+			"aws_ses_receipt_filter":           ses.DataSourceReceiptFilter(),
+			"aws_ses_receipt_filter_conflicts": ses.DataSourceReceiptFilterConflicts(),
+			"aws_ses_receipt_filters":          ses.DataSourceReceiptFilters(),
 
 			"aws_db_cluster_snapshot":       rds.DataSourceClusterSnapshot(),
 			"aws_db_event_categories":       rds.DataSourceEventCategories(),
@@ -787,9 +797,11 @@ func Provider() *schema.Provider {
 			"aws_rds_engine_version":        rds.DataSourceEngineVersion(),
 			"aws_rds_orderable_db_instance": rds.DataSourceOrderableInstance(),
 
-			"aws_redshift_cluster":           redshift.DataSourceCluster(),
-			"aws_redshift_orderable_cluster": redshift.DataSourceOrderableCluster(),
-			"aws_redshift_service_account":   redshift.DataSourceServiceAccount(),
+			"aws_redshift_cluster":            redshift.DataSourceCluster(),
+			"aws_redshift_orderable_cluster":  redshift.DataSourceOrderableCluster(),
+			"aws_redshift_service_account":    redshift.DataSourceServiceAccount(),
+			"aws_redshift_snapshot_schedule":  redshift.DataSourceSnapshotSchedule(),
+			"aws_redshift_snapshot_schedules": redshift.DataSourceSnapshotSchedules(),
 
 			"aws_resourcegroupstaggingapi_resources": resourcegroupstaggingapi.DataSourceResources(),
 
@@ -864,10 +876,12 @@ func Provider() *schema.Provider {
 			"aws_wafregional_rate_based_rule": wafregional.DataSourceRateBasedRule(),
 			"aws_wafregional_web_acl":         wafregional.DataSourceWebACL(),
 
-			"aws_wafv2_ip_set":            wafv2.DataSourceIPSet(),
-			"aws_wafv2_regex_pattern_set": wafv2.DataSourceRegexPatternSet(),
-			"aws_wafv2_rule_group":        wafv2.DataSourceRuleGroup(),
-			"aws_wafv2_web_acl":           wafv2.DataSourceWebACL(),
+			"aws_wafv2_ip_set":              wafv2.DataSourceIPSet(),
+			"aws_wafv2_regex_pattern_set":   wafv2.DataSourceRegexPatternSet(),
+			"aws_wafv2_rule_group":          wafv2.DataSourceRuleGroup(),
+			"aws_wafv2_web_acl":             wafv2.DataSourceWebACL(),
+			"aws_wafv2_web_acl_association": wafv2.DataSourceWebACLAssociation(),
+			"aws_wafv2_web_acl_resources":   wafv2.DataSourceWebACLResources(),
 
 			"aws_workspaces_bundle":    workspaces.DataSourceBundle(),
 			"aws_workspaces_directory": workspaces.DataSourceDirectory(),
@@ -876,7 +890,8 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_analyzer":         accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_findings_archive": accessanalyzer.ResourceFindingsArchive(),
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
 
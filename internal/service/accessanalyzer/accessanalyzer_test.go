@@ -12,11 +12,35 @@ import (
 // locally and in TeamCity.
 func TestAccAccessAnalyzer_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
+		"AnalyzedResourceDataSource": {
+			"basic":    testAccAnalyzedResourceDataSource_basic,
+			"notFound": testAccAnalyzedResourceDataSource_notFound,
+		},
 		"Analyzer": {
-			"basic":             testAccAnalyzer_basic,
-			"disappears":        testAccAnalyzer_disappears,
-			"Tags":              testAccAnalyzer_Tags,
-			"Type_Organization": testAccAnalyzer_Type_Organization,
+			"basic":              testAccAnalyzer_basic,
+			"DeletionProtection": testAccAnalyzer_DeletionProtection,
+			"disappears":         testAccAnalyzer_disappears,
+			"FindingsCounts":     testAccAnalyzer_findingsCounts,
+			"ImportInvalidName":  testAccAnalyzer_ImportInvalidName,
+			"NamePrefix":         testAccAnalyzer_NamePrefix,
+			"RecreateSameName":   testAccAnalyzer_RecreateSameName,
+			"Tags":               testAccAnalyzer_Tags,
+			"Timeouts":           testAccAnalyzer_Timeouts,
+			"Type_Organization":  testAccAnalyzer_Type_Organization,
+		},
+		"AnalyzersDataSource": {
+			"Status": testAccAnalyzersDataSource_status,
+			"Tags":   testAccAnalyzersDataSource_tags,
+		},
+		"ArchiveRulePreviewDataSource": {
+			"basic": testAccArchiveRulePreviewDataSource_basic,
+		},
+		"FindingDataSource": {
+			"basic":    testAccFindingDataSource_basic,
+			"notFound": testAccFindingDataSource_notFound,
+		},
+		"FindingsArchive": {
+			"basic": testAccFindingsArchive_basic,
 		},
 	}
 
@@ -0,0 +1,86 @@
+package accessanalyzer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceAnalyzedResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAnalyzedResourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"analyzer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"is_public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_owner_account": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared_via": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAnalyzedResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	resourceARN := d.Get("resource_arn").(string)
+
+	analyzedResource, err := FindAnalyzedResourceByAnalyzerARNAndResourceARN(ctx, conn, analyzerARN, resourceARN)
+
+	// Unsupported resources and resources the analyzer has not yet scanned are
+	// both reported as not found.
+	if tfresource.NotFound(err) {
+		return diag.Errorf("no Access Analyzer analyzed resource (%s) found for Analyzer (%s); the resource may not be supported or not yet analyzed", resourceARN, analyzerARN)
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Access Analyzer analyzed resource (%s): %s", resourceARN, err)
+	}
+
+	d.SetId(aws.StringValue(analyzedResource.ResourceArn))
+	d.Set("actions", aws.StringValueSlice(analyzedResource.Actions))
+	d.Set("is_public", analyzedResource.IsPublic)
+	d.Set("resource_owner_account", analyzedResource.ResourceOwnerAccount)
+	d.Set("resource_type", analyzedResource.ResourceType)
+	d.Set("shared_via", aws.StringValueSlice(analyzedResource.SharedVia))
+	d.Set("status", analyzedResource.Status)
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package accessanalyzer_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+)
+
+func TestAnalyzedResourceDataSourceRead(t *testing.T) {
+	const (
+		analyzerARN    = "arn:aws:access-analyzer:us-west-2:123456789012:analyzer/test" //lintignore:AWSAT003,AWSAT005
+		bucketARN      = "arn:aws:s3:::test"                                            //lintignore:AWSAT005
+		missingARN     = "arn:aws:s3:::missing"                                         //lintignore:AWSAT005
+		resourceAcctID = "123456789012"
+	)
+
+	conn := tfaccessanalyzer.NewMockConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *accessanalyzer.GetAnalyzedResourceOutput:
+			input := r.Params.(*accessanalyzer.GetAnalyzedResourceInput)
+
+			if aws.StringValue(input.AnalyzerArn) != analyzerARN {
+				t.Errorf("got analyzer ARN %s, expected %s", aws.StringValue(input.AnalyzerArn), analyzerARN)
+			}
+
+			if aws.StringValue(input.ResourceArn) != bucketARN {
+				r.Error = awserr.New(accessanalyzer.ErrCodeResourceNotFoundException, "not found", nil)
+				return
+			}
+
+			data.Resource = &accessanalyzer.AnalyzedResource{
+				Actions:              aws.StringSlice([]string{"s3:GetObject", "s3:ListBucket"}),
+				IsPublic:             aws.Bool(true),
+				ResourceArn:          aws.String(bucketARN),
+				ResourceOwnerAccount: aws.String(resourceAcctID),
+				ResourceType:         aws.String(accessanalyzer.ResourceTypeAwsS3Bucket),
+				SharedVia:            aws.StringSlice([]string{"POLICY"}),
+				Status:               aws.String(accessanalyzer.FindingStatusActive),
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	r := tfaccessanalyzer.DataSourceAnalyzedResource()
+	meta := &conns.AWSClient{AccessAnalyzerConn: conn}
+
+	t.Run("found", func(t *testing.T) {
+		d := r.TestResourceData()
+		d.Set("analyzer_arn", analyzerARN)
+		d.Set("resource_arn", bucketARN)
+
+		if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got, want := d.Id(), bucketARN; got != want {
+			t.Errorf("got ID %s, expected %s", got, want)
+		}
+
+		if !d.Get("is_public").(bool) {
+			t.Error("got is_public false, expected true")
+		}
+
+		if got, want := d.Get("status").(string), accessanalyzer.FindingStatusActive; got != want {
+			t.Errorf("got status %s, expected %s", got, want)
+		}
+
+		if got, want := d.Get("resource_owner_account").(string), resourceAcctID; got != want {
+			t.Errorf("got resource_owner_account %s, expected %s", got, want)
+		}
+
+		if got, want := d.Get("actions").([]interface{}), []interface{}{"s3:GetObject", "s3:ListBucket"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got actions %v, expected %v", got, want)
+		}
+
+		if got, want := d.Get("shared_via").([]interface{}), []interface{}{"POLICY"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got shared_via %v, expected %v", got, want)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		d := r.TestResourceData()
+		d.Set("analyzer_arn", analyzerARN)
+		d.Set("resource_arn", missingARN)
+
+		diags := r.ReadContext(context.Background(), d, meta)
+
+		if !diags.HasError() {
+			t.Fatal("expected error, got none")
+		}
+
+		if got := diags[0].Summary; !strings.Contains(got, fmt.Sprintf("no Access Analyzer analyzed resource (%s) found", missingARN)) {
+			t.Errorf("unexpected error: %s", got)
+		}
+	})
+}
+
+// This test can be run via the pattern: TestAccAccessAnalyzer_serial
+func testAccAnalyzedResourceDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	analyzerResourceName := "aws_accessanalyzer_analyzer.test"
+	queueResourceName := "aws_sqs_queue.test"
+	dataSourceName := "data.aws_accessanalyzer_analyzed_resource.test"
+	var findingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckFinding(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingDataSourceBaseConfig(rName),
+				Check:  testAccCheckFindingExists(analyzerResourceName, queueResourceName, &findingID),
+			},
+			{
+				Config: testAccAnalyzedResourceDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_arn", queueResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_owner_account", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_type", accessanalyzer.ResourceTypeAwsSqsQueue),
+					resource.TestCheckResourceAttr(dataSourceName, "status", accessanalyzer.FindingStatusActive),
+					resource.TestCheckResourceAttr(dataSourceName, "is_public", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "actions.0", "sqs:SendMessage"),
+				),
+			},
+		},
+	})
+}
+
+// This test can be run via the pattern: TestAccAccessAnalyzer_serial
+func testAccAnalyzedResourceDataSource_notFound(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnalyzedResourceDataSourceNotFoundConfig(rName),
+				ExpectError: regexp.MustCompile(`no Access Analyzer analyzed resource \(.+\) found`),
+			},
+		},
+	})
+}
+
+func testAccAnalyzedResourceDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFindingDataSourceBaseConfig(rName), `
+data "aws_accessanalyzer_analyzed_resource" "test" {
+  analyzer_arn = aws_accessanalyzer_analyzer.test.arn
+  resource_arn = aws_sqs_queue.test.arn
+}
+`)
+}
+
+func testAccAnalyzedResourceDataSourceNotFoundConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+
+data "aws_accessanalyzer_analyzed_resource" "test" {
+  analyzer_arn = aws_accessanalyzer_analyzer.test.arn
+  resource_arn = "arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s"
+}
+`, rName)
+}
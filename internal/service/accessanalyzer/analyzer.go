@@ -1,55 +1,127 @@
 package accessanalyzer
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 const (
-	// Maximum amount of time to wait for Organizations eventual consistency on creation
+	// Default maximum amount of time to wait for Organizations eventual consistency on creation
 	// This timeout value is much higher than usual since the cross-service validation
 	// appears to be consistently caching for 5 minutes:
 	// --- PASS: TestAccAWSAccessAnalyzer_serial/Analyzer/Type_Organization (315.86s)
 	accessAnalyzerOrganizationCreationTimeout = 10 * time.Minute
+
+	// Default maximum amount of time to wait for a conflicting operation to complete on deletion
+	// and for the deleted analyzer to no longer be found
+	accessAnalyzerDeletionTimeout = 10 * time.Minute
+
+	analyzerNameMaxLength = 255
+
+	// Maximum number of ListFindings pages counted on read, so that analyzers
+	// with very many findings do not make every refresh slow.
+	analyzerFindingsCountMaxPages = 100
+
+	// Name and service principal of the service-linked role that analyzers require
+	analyzerServiceLinkedRoleName        = "AWSServiceRoleForAccessAnalyzer"
+	analyzerServiceLinkedRoleServiceName = "access-analyzer.amazonaws.com"
 )
 
+var analyzerNameRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]*$`)
+
+// Matches the Organizations eventual consistency error in all partitions, e.g.
+// "You must create an organization" and GovCloud's "You must first create an AWS Organization".
+var organizationNotFoundMessageRegexp = regexp.MustCompile(`(?i)must (first )?create an (aws )?organization`)
+
+var serviceLinkedRoleMissingMessageRegexp = regexp.MustCompile(`(?i)service-linked role .*does not exist`)
+
+const analyzerNameRegexpMessage = "must begin with a letter and contain only alphanumeric, underscore, period, or hyphen characters"
+
 func ResourceAnalyzer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAnalyzerCreate,
-		Read:   resourceAnalyzerRead,
-		Update: resourceAnalyzerUpdate,
-		Delete: resourceAnalyzerDelete,
+		CreateContext: resourceAnalyzerCreate,
+		ReadContext:   resourceAnalyzerRead,
+		UpdateContext: resourceAnalyzerUpdate,
+		DeleteContext: resourceAnalyzerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceAnalyzerImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(accessAnalyzerOrganizationCreationTimeout),
+			Delete: schema.DefaultTimeout(accessAnalyzerDeletionTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"active_findings_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"analyzer_name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"analyzer_name", "name_prefix"},
 				ValidateFunc: validation.All(
-					validation.StringLenBetween(1, 255),
-					validation.StringMatch(regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]*$`), "must begin with a letter and contain only alphanumeric, underscore, period, or hyphen characters"),
+					validation.StringLenBetween(1, analyzerNameMaxLength),
+					validation.StringMatch(analyzerNameRegexp, analyzerNameRegexpMessage),
 				),
 			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"count_findings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"findings_count_truncated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"analyzer_name", "name_prefix"},
+				// The generated name is the prefix followed by a unique numeric suffix,
+				// so a valid prefix always produces a valid analyzer name.
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, analyzerNameMaxLength-resource.UniqueIDSuffixLength),
+					validation.StringMatch(analyzerNameRegexp, analyzerNameRegexpMessage),
+				),
+			},
+			"public_findings_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"type": {
@@ -64,15 +136,51 @@ func ResourceAnalyzer() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			resourceAnalyzerTypeDiff,
+		),
 	}
 }
 
-func resourceAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
+// resourceAnalyzerTypeDiff warns when a type change will replace an existing analyzer.
+// CustomizeDiff cannot return warning diagnostics, so the warning is logged.
+func resourceAnalyzerTypeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("type") {
+		return nil
+	}
+
+	o, n := diff.GetChange("type")
+
+	log.Printf("[WARN] %s", analyzerTypeChangeWarning(diff.Id(), o.(string), n.(string)))
+
+	return nil
+}
+
+func analyzerTypeChangeWarning(name, oldType, newType string) string {
+	return fmt.Sprintf("Changing Access Analyzer Analyzer (%s) type from %s to %s replaces the analyzer: "+
+		"its findings and archive rules are deleted, and resources are not analyzed until the new analyzer has been created", name, oldType, newType)
+}
+
+// resourceAnalyzerImport rejects malformed analyzer names, which would otherwise
+// only surface as a less helpful API error on read, and sets the Terraform-only
+// arguments to their defaults.
+func resourceAnalyzerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if name := d.Id(); len(name) > analyzerNameMaxLength || !analyzerNameRegexp.MatchString(name) {
+		return nil, fmt.Errorf("invalid Access Analyzer Analyzer name (%s) for import: %s and be 1 to %d characters long", name, analyzerNameRegexpMessage, analyzerNameMaxLength)
+	}
+
+	d.Set("count_findings", false)
+	d.Set("deletion_protection", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAnalyzerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
-	analyzerName := d.Get("analyzer_name").(string)
+	analyzerName := create.Name(d.Get("analyzer_name").(string), d.Get("name_prefix").(string))
 
 	input := &accessanalyzer.CreateAnalyzerInput{
 		AnalyzerName: aws.String(analyzerName),
@@ -81,11 +189,14 @@ func resourceAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
 		Type:         aws.String(d.Get("type").(string)),
 	}
 
+	// The create timeout covers both the retries and waiting for the analyzer to become active.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
 	// Handle Organizations eventual consistency
-	err := resource.Retry(accessAnalyzerOrganizationCreationTimeout, func() *resource.RetryError {
-		_, err := conn.CreateAnalyzer(input)
+	err := resource.RetryContext(ctx, time.Until(deadline), func() *resource.RetryError {
+		_, err := conn.CreateAnalyzerWithContext(ctx, input)
 
-		if tfawserr.ErrMessageContains(err, accessanalyzer.ErrCodeValidationException, "You must create an organization") {
+		if isOrganizationNotFoundError(err) {
 			return resource.RetryableError(err)
 		}
 
@@ -97,19 +208,28 @@ func resourceAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.CreateAnalyzer(input)
+		_, err = conn.CreateAnalyzerWithContext(ctx, input)
+	}
+
+	if isServiceLinkedRoleMissingError(err) {
+		return diag.FromErr(fmt.Errorf("error creating Access Analyzer Analyzer (%s): the IAM Access Analyzer service-linked role (%s) does not exist in this account. "+
+			"Create it, e.g. with an aws_iam_service_linked_role resource with aws_service_name = %q, and try again: %w", analyzerName, analyzerServiceLinkedRoleName, analyzerServiceLinkedRoleServiceName, err))
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating Access Analyzer Analyzer (%s): %s", analyzerName, err)
+		return diag.Errorf("error creating Access Analyzer Analyzer (%s): %s", analyzerName, err)
 	}
 
 	d.SetId(analyzerName)
 
-	return resourceAnalyzerRead(d, meta)
+	if _, err := waitAnalyzerCreated(ctx, conn, d.Id(), time.Until(deadline)); err != nil {
+		return diag.Errorf("error waiting for Access Analyzer Analyzer (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAnalyzerRead(ctx, d, meta)
 }
 
-func resourceAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAnalyzerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -118,7 +238,7 @@ func resourceAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
 		AnalyzerName: aws.String(d.Id()),
 	}
 
-	output, err := conn.GetAnalyzer(input)
+	output, err := conn.GetAnalyzerWithContext(ctx, input)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Access Analyzer Analyzer (%s) not found, removing from state", d.Id())
@@ -127,60 +247,205 @@ func resourceAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error getting Access Analyzer Analyzer (%s): %s", d.Id(), err)
+		return diag.Errorf("error getting Access Analyzer Analyzer (%s): %s", d.Id(), err)
 	}
 
 	if output == nil || output.Analyzer == nil {
-		return fmt.Errorf("error getting Access Analyzer Analyzer (%s): empty response", d.Id())
+		return diag.Errorf("error getting Access Analyzer Analyzer (%s): empty response", d.Id())
 	}
 
 	d.Set("analyzer_name", output.Analyzer.Name)
-	d.Set("arn", output.Analyzer.Arn)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Analyzer.Name)))
+
+	arn, err := analyzerARN(meta.(*conns.AWSClient), d.Id(), aws.StringValue(output.Analyzer.Arn))
+
+	if err != nil {
+		return diag.Errorf("error getting Access Analyzer Analyzer (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", arn)
 
 	tags := KeyValueTags(output.Analyzer.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
 	d.Set("type", output.Analyzer.Type)
 
+	// Counting findings can take many ListFindings calls, so it is only done on request.
+	if !d.Get("count_findings").(bool) {
+		d.Set("active_findings_count", nil)
+		d.Set("findings_count_truncated", nil)
+		d.Set("public_findings_count", nil)
+
+		return nil
+	}
+
+	activeFindingsCount, publicFindingsCount, truncated, err := analyzerFindingsCounts(ctx, conn, arn)
+
+	// Counting findings needs an additional permission, which is not required to manage the analyzer.
+	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeAccessDeniedException) {
+		log.Printf("[WARN] Unable to count Access Analyzer Analyzer (%s) findings: %s", d.Id(), err)
+	} else if err != nil {
+		return diag.Errorf("error counting Access Analyzer Analyzer (%s) findings: %s", d.Id(), err)
+	} else {
+		d.Set("active_findings_count", activeFindingsCount)
+		d.Set("findings_count_truncated", truncated)
+		d.Set("public_findings_count", publicFindingsCount)
+	}
+
 	return nil
 }
 
-func resourceAnalyzerUpdate(d *schema.ResourceData, meta interface{}) error {
+// analyzerFindingsCounts returns the number of active findings of an analyzer
+// and how many of them are for publicly accessible resources. At most
+// analyzerFindingsCountMaxPages pages of findings are counted, and whether
+// counting stopped there is returned.
+func analyzerFindingsCounts(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, arn string) (int, int, bool, error) {
+	input := &accessanalyzer.ListFindingsInput{
+		AnalyzerArn: aws.String(arn),
+		Filter: map[string]*accessanalyzer.Criterion{
+			"status": {
+				Eq: aws.StringSlice([]string{accessanalyzer.FindingStatusActive}),
+			},
+		},
+		MaxResults: aws.Int64(100),
+	}
+	var active, public, pages int
+	var truncated bool
+
+	err := conn.ListFindingsPagesWithContext(ctx, input, func(page *accessanalyzer.ListFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Findings {
+			if v == nil {
+				continue
+			}
+
+			active++
+
+			if aws.BoolValue(v.IsPublic) {
+				public++
+			}
+		}
+
+		if pages++; !lastPage && pages >= analyzerFindingsCountMaxPages {
+			log.Printf("[WARN] Access Analyzer Analyzer (%s) has more active findings than are counted, counting only the first %d", arn, active)
+			truncated = true
+			return false
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	return active, public, truncated, nil
+}
+
+func resourceAnalyzerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating Access Analyzer Analyzer (%s) tags: %s", d.Id(), err)
+
+		// The ARN in state may be stale, e.g. if the analyzer was recreated
+		// outside Terraform, so tag the ARN of the current analyzer.
+		analyzer, err := FindAnalyzerByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("error reading Access Analyzer Analyzer (%s): %s", d.Id(), err)
+		}
+
+		arn, err := analyzerARN(meta.(*conns.AWSClient), d.Id(), aws.StringValue(analyzer.Arn))
+
+		if err != nil {
+			return diag.Errorf("error updating Access Analyzer Analyzer (%s) tags: %s", d.Id(), err)
 		}
+
+		if stateARN := d.Get("arn").(string); stateARN != "" && stateARN != arn {
+			log.Printf("[WARN] Access Analyzer Analyzer (%s) ARN changed from %s to %s", d.Id(), stateARN, arn)
+		}
+
+		if err := updateTagsWithRetry(ctx, conn, arn, o, n); err != nil {
+			return diag.Errorf("error updating Access Analyzer Analyzer (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAnalyzerRead(ctx, d, meta)
+}
+
+// isOrganizationNotFoundError returns whether an analyzer could not be created
+// because the organization is not yet visible to Access Analyzer.
+func isOrganizationNotFoundError(err error) bool {
+	var awsErr awserr.Error
+
+	if !errors.As(err, &awsErr) || awsErr.Code() != accessanalyzer.ErrCodeValidationException {
+		return false
+	}
+
+	return organizationNotFoundMessageRegexp.MatchString(awsErr.Message())
+}
+
+// isServiceLinkedRoleMissingError returns whether an analyzer could not be created
+// because the Access Analyzer service-linked role does not exist.
+// Failing to create the role, e.g. without iam:CreateServiceLinkedRole, is a different error.
+func isServiceLinkedRoleMissingError(err error) bool {
+	var awsErr awserr.Error
+
+	if !errors.As(err, &awsErr) || awsErr.Code() != accessanalyzer.ErrCodeValidationException {
+		return false
 	}
 
-	return resourceAnalyzerRead(d, meta)
+	return serviceLinkedRoleMissingMessageRegexp.MatchString(awsErr.Message())
 }
 
-func resourceAnalyzerDelete(d *schema.ResourceData, meta interface{}) error {
+// analyzerARN returns arn, or the analyzer ARN in the client's partition and region if the API omitted it.
+func analyzerARN(client *conns.AWSClient, name, arn string) (string, error) {
+	if arn != "" {
+		return arn, nil
+	}
+
+	return client.RegionalARN("access-analyzer", fmt.Sprintf("analyzer/%s", name))
+}
+
+func resourceAnalyzerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("error deleting Access Analyzer Analyzer (%s): deletion protection is enabled, set deletion_protection to false and apply before deleting", d.Id())
+	}
+
 	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
 
-	log.Printf("[DEBUG] Deleting Access Analyzer Analyzer: (%s)", d.Id())
-	_, err := conn.DeleteAnalyzer(&accessanalyzer.DeleteAnalyzerInput{
+	input := &accessanalyzer.DeleteAnalyzerInput{
 		AnalyzerName: aws.String(d.Id()),
 		ClientToken:  aws.String(resource.UniqueId()),
-	})
+	}
+
+	log.Printf("[DEBUG] Deleting Access Analyzer Analyzer: (%s)", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteAnalyzerWithContext(ctx, input)
+	}, accessanalyzer.ErrCodeConflictException)
 
 	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting Access Analyzer Analyzer (%s): %w", d.Id(), err)
+		return diag.Errorf("error deleting Access Analyzer Analyzer (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAnalyzerDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Access Analyzer Analyzer (%s) delete: %s", d.Id(), err)
 	}
 
 	return nil
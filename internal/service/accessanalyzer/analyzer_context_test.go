@@ -0,0 +1,425 @@
+package accessanalyzer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestResourceAnalyzerCreate_contextCanceled(t *testing.T) {
+	conn := newMockAccessAnalyzerConn(t, func(r *request.Request) {
+		if _, ok := r.Params.(*accessanalyzer.CreateAnalyzerInput); !ok {
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+			return
+		}
+
+		// Organizations eventual consistency is retried until the create timeout.
+		r.Error = awserr.New(accessanalyzer.ErrCodeValidationException, "You must create an organization", nil)
+	})
+
+	r := ResourceAnalyzer()
+	d := r.TestResourceData()
+	d.Set("analyzer_name", "test")
+	d.Set("type", accessanalyzer.TypeOrganization)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	diags := r.CreateContext(ctx, d, &conns.AWSClient{AccessAnalyzerConn: conn})
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("create took %s after context cancellation, expected it to return promptly", elapsed)
+	}
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected no ID to be set, got %s", d.Id())
+	}
+}
+
+func TestResourceAnalyzerCreate_timeoutShared(t *testing.T) {
+	start := time.Now()
+	conn := newMockAccessAnalyzerConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *accessanalyzer.CreateAnalyzerOutput:
+			// Organizations eventual consistency for the first second.
+			if time.Since(start) < time.Second {
+				r.Error = awserr.New(accessanalyzer.ErrCodeValidationException, "You must create an organization", nil)
+			}
+		case *accessanalyzer.GetAnalyzerOutput:
+			// The analyzer never becomes active.
+			data.Analyzer = &accessanalyzer.AnalyzerSummary{
+				Name:   aws.String("test"),
+				Status: aws.String(accessanalyzer.AnalyzerStatusCreating),
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	r := ResourceAnalyzer()
+	r.Timeouts.Create = schema.DefaultTimeout(4 * time.Second)
+	d := r.Data(nil)
+	d.Set("analyzer_name", "test")
+	d.Set("type", accessanalyzer.TypeOrganization)
+
+	diags := r.CreateContext(context.Background(), d, &conns.AWSClient{AccessAnalyzerConn: conn})
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	// Waiting for the analyzer only gets the time left after retrying the create.
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("create took %s, expected it to stop after the 4s create timeout", elapsed)
+	}
+}
+
+func TestResourceAnalyzerCreate_serviceLinkedRoleMissing(t *testing.T) {
+	var createCalls int
+	conn := newMockAccessAnalyzerConn(t, func(r *request.Request) {
+		if _, ok := r.Params.(*accessanalyzer.CreateAnalyzerInput); !ok {
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+			return
+		}
+
+		createCalls++
+		r.Error = awserr.New(accessanalyzer.ErrCodeValidationException, "Service-linked role for IAM Access Analyzer does not exist in the account", nil)
+	})
+
+	r := ResourceAnalyzer()
+	d := r.TestResourceData()
+	d.Set("analyzer_name", "test")
+	d.Set("type", accessanalyzer.TypeOrganization)
+
+	diags := r.CreateContext(context.Background(), d, &conns.AWSClient{AccessAnalyzerConn: conn})
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	if createCalls != 1 {
+		t.Errorf("got %d CreateAnalyzer calls, expected the error not to be retried", createCalls)
+	}
+
+	summary := diags[0].Summary
+
+	for _, want := range []string{"AWSServiceRoleForAccessAnalyzer", "aws_iam_service_linked_role", "access-analyzer.amazonaws.com"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("expected error %q to contain %q", summary, want)
+		}
+	}
+
+	if !strings.Contains(summary, "does not exist in the account") {
+		t.Errorf("expected error %q to contain the AWS message", summary)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected no ID to be set, got %s", d.Id())
+	}
+}
+
+func TestResourceAnalyzerCreate_organizationNotFoundGovCloud(t *testing.T) {
+	var createCalls int
+	conn := newMockAccessAnalyzerConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *accessanalyzer.CreateAnalyzerOutput:
+			// The first attempt hits Organizations eventual consistency.
+			if createCalls++; createCalls == 1 {
+				r.Error = awserr.New(accessanalyzer.ErrCodeValidationException, "You must first create an AWS Organization before creating an analyzer with type ORGANIZATION", nil)
+			}
+		case *accessanalyzer.GetAnalyzerOutput:
+			data.Analyzer = &accessanalyzer.AnalyzerSummary{
+				Arn:    aws.String("arn:aws-us-gov:access-analyzer:us-gov-west-1:123456789012:analyzer/test"),
+				Name:   aws.String("test"),
+				Status: aws.String(accessanalyzer.AnalyzerStatusActive),
+				Type:   aws.String(accessanalyzer.TypeOrganization),
+			}
+		case *accessanalyzer.ListFindingsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	r := ResourceAnalyzer()
+	d := r.TestResourceData()
+	d.Set("analyzer_name", "test")
+	d.Set("type", accessanalyzer.TypeOrganization)
+
+	meta := &conns.AWSClient{
+		AccessAnalyzerConn: conn,
+		AccountID:          "123456789012",
+		Partition:          "aws-us-gov",
+		Region:             "us-gov-west-1",
+	}
+
+	if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if createCalls != 2 {
+		t.Errorf("got %d CreateAnalyzer calls, expected the GovCloud error to be retried", createCalls)
+	}
+
+	if got, expected := d.Id(), "test"; got != expected {
+		t.Errorf("got ID %s, expected %s", got, expected)
+	}
+}
+
+func TestIsOrganizationNotFoundError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name:     "commercial",
+			Err:      awserr.New(accessanalyzer.ErrCodeValidationException, "You must create an organization", nil),
+			Expected: true,
+		},
+		{
+			Name:     "GovCloud",
+			Err:      awserr.New(accessanalyzer.ErrCodeValidationException, "You must first create an AWS Organization before creating an analyzer with type ORGANIZATION", nil),
+			Expected: true,
+		},
+		{
+			Name:     "wrapped",
+			Err:      fmt.Errorf("creating: %w", awserr.New(accessanalyzer.ErrCodeValidationException, "you must create an organization", nil)),
+			Expected: true,
+		},
+		{
+			Name: "other code",
+			Err:  awserr.New(accessanalyzer.ErrCodeAccessDeniedException, "You must create an organization", nil),
+		},
+		{
+			Name: "service-linked role",
+			Err:  awserr.New(accessanalyzer.ErrCodeValidationException, "Service-linked role for IAM Access Analyzer does not exist in the account", nil),
+		},
+		{
+			Name: "not an AWS error",
+			Err:  errors.New("You must create an organization"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isOrganizationNotFoundError(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIsServiceLinkedRoleMissingError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name:     "service-linked role",
+			Err:      awserr.New(accessanalyzer.ErrCodeValidationException, "Service-linked role for IAM Access Analyzer does not exist in the account", nil),
+			Expected: true,
+		},
+		{
+			Name:     "wrapped",
+			Err:      fmt.Errorf("creating: %w", awserr.New(accessanalyzer.ErrCodeValidationException, "Service-linked role for IAM Access Analyzer does not exist in the account", nil)),
+			Expected: true,
+		},
+		{
+			Name: "role creation denied",
+			Err:  awserr.New(accessanalyzer.ErrCodeAccessDeniedException, "Unable to create the service-linked role", nil),
+		},
+		{
+			Name: "other validation error",
+			Err:  awserr.New(accessanalyzer.ErrCodeValidationException, "Unable to create the service-linked role", nil),
+		},
+		{
+			Name: "organization",
+			Err:  awserr.New(accessanalyzer.ErrCodeValidationException, "You must create an organization", nil),
+		},
+		{
+			Name: "not an AWS error",
+			Err:  errors.New("service-linked role"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isServiceLinkedRoleMissingError(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestResourceAnalyzerDelete_waitUntilGone(t *testing.T) {
+	var deleted bool
+	var getCalls int
+
+	conn := newMockAccessAnalyzerConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *accessanalyzer.DeleteAnalyzerOutput:
+			deleted = true
+		case *accessanalyzer.GetAnalyzerOutput:
+			if !deleted {
+				t.Error("GetAnalyzer called before DeleteAnalyzer")
+			}
+
+			// The deleted analyzer is still reported for a couple of polls.
+			if getCalls++; getCalls < 3 {
+				data.Analyzer = &accessanalyzer.AnalyzerSummary{
+					Name:   aws.String("test"),
+					Status: aws.String(accessanalyzer.AnalyzerStatusActive),
+				}
+				return
+			}
+
+			r.Error = awserr.New(accessanalyzer.ErrCodeResourceNotFoundException, "Analyzer not found", nil)
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	r := ResourceAnalyzer()
+	d := r.TestResourceData()
+	d.SetId("test")
+
+	if diags := r.DeleteContext(context.Background(), d, &conns.AWSClient{AccessAnalyzerConn: conn}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if getCalls != 3 {
+		t.Errorf("got %d GetAnalyzer calls, expected delete to wait until the analyzer is not found", getCalls)
+	}
+}
+
+func TestResourceAnalyzerRead_findingsCounts(t *testing.T) {
+	var listFindingsErr error
+	var pages int
+	conn := newMockAccessAnalyzerConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *accessanalyzer.GetAnalyzerOutput:
+			data.Analyzer = &accessanalyzer.AnalyzerSummary{
+				Arn:    aws.String("arn:aws:access-analyzer:us-west-2:123456789012:analyzer/test"), //lintignore:AWSAT003,AWSAT005
+				Name:   aws.String("test"),
+				Status: aws.String(accessanalyzer.AnalyzerStatusActive),
+				Type:   aws.String(accessanalyzer.TypeAccount),
+			}
+		case *accessanalyzer.ListFindingsOutput:
+			if listFindingsErr != nil {
+				r.Error = listFindingsErr
+				return
+			}
+
+			input := r.Params.(*accessanalyzer.ListFindingsInput)
+
+			if expected := aws.StringSlice([]string{accessanalyzer.FindingStatusActive}); !reflect.DeepEqual(input.Filter["status"].Eq, expected) {
+				t.Errorf("got status filter %v, expected only active findings to be counted", input.Filter["status"])
+			}
+
+			// A pager that never ends, with one public finding per page.
+			pages++
+			data.Findings = []*accessanalyzer.FindingSummary{
+				{Id: aws.String(fmt.Sprintf("%d-1", pages)), IsPublic: aws.Bool(true)},
+				{Id: aws.String(fmt.Sprintf("%d-2", pages)), IsPublic: aws.Bool(false)},
+			}
+			data.NextToken = aws.String(fmt.Sprintf("page%d", pages+1))
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccessAnalyzerConn: conn,
+		AccountID:          "123456789012",
+		Partition:          "aws",
+		Region:             "us-west-2",
+	}
+
+	r := ResourceAnalyzer()
+	d := r.TestResourceData()
+	d.SetId("test")
+
+	// Findings are not counted unless requested.
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if pages != 0 {
+		t.Errorf("got %d ListFindings calls, expected none without count_findings", pages)
+	}
+
+	d.Set("count_findings", true)
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if pages != analyzerFindingsCountMaxPages {
+		t.Errorf("got %d ListFindings calls, expected %d", pages, analyzerFindingsCountMaxPages)
+	}
+
+	if got, expected := d.Get("active_findings_count").(int), 2*analyzerFindingsCountMaxPages; got != expected {
+		t.Errorf("got active_findings_count %d, expected %d", got, expected)
+	}
+
+	if got, expected := d.Get("public_findings_count").(int), analyzerFindingsCountMaxPages; got != expected {
+		t.Errorf("got public_findings_count %d, expected %d", got, expected)
+	}
+
+	if !d.Get("findings_count_truncated").(bool) {
+		t.Errorf("got findings_count_truncated false, expected counting to stop after %d pages", analyzerFindingsCountMaxPages)
+	}
+
+	// Without permission to list findings, the counts are left as they were.
+	listFindingsErr = awserr.New(accessanalyzer.ErrCodeAccessDeniedException, "not authorized to perform: access-analyzer:ListFindings", nil)
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, expected := d.Get("active_findings_count").(int), 2*analyzerFindingsCountMaxPages; got != expected {
+		t.Errorf("got active_findings_count %d after access denied, expected %d", got, expected)
+	}
+}
+
+// newMockAccessAnalyzerConn returns an Access Analyzer client that hands every
+// request to send instead of calling AWS. send fills in the request's output or
+// sets its error.
+func newMockAccessAnalyzerConn(t *testing.T, send func(r *request.Request)) *accessanalyzer.AccessAnalyzer {
+	t.Helper()
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := accessanalyzer.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(send)
+
+	return conn
+}
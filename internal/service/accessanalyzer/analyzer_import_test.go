@@ -0,0 +1,61 @@
+package accessanalyzer
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestResourceAnalyzerImport(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ID          string
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			ID:   "tf-acc-test_analyzer.1",
+		},
+		{
+			Name:        "leading digit",
+			ID:          "1-analyzer",
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid character",
+			ID:          "analyzer name",
+			ExpectError: true,
+		},
+		{
+			Name:        "too long",
+			ID:          "a" + strings.Repeat("b", analyzerNameMaxLength),
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := ResourceAnalyzer()
+			d := r.TestResourceData()
+			d.SetId(testCase.ID)
+
+			_, err := r.Importer.StateContext(context.Background(), d, nil)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !strings.Contains(err.Error(), analyzerNameRegexpMessage) {
+					t.Errorf("expected error %q to describe the naming rules", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
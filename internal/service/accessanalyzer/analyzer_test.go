@@ -2,6 +2,7 @@ package accessanalyzer_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 )
 
 // This test can be run via the pattern: TestAccAWSAccessAnalyzer
@@ -32,6 +34,9 @@ func testAccAnalyzer_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAnalyzerExists(resourceName, &analyzer),
 					resource.TestCheckResourceAttr(resourceName, "analyzer_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "count_findings", "false"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "access-analyzer", fmt.Sprintf("analyzer/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "type", accessanalyzer.TypeAccount),
@@ -46,6 +51,108 @@ func testAccAnalyzer_basic(t *testing.T) {
 	})
 }
 
+// This test can be run via the pattern: TestAccAccessAnalyzer_serial
+func testAccAnalyzer_findingsCounts(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_analyzer.test"
+	var findingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckFinding(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalyzerFindingsCountsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "active_findings_count", regexp.MustCompile(`^\d+$`)),
+					resource.TestMatchResourceAttr(resourceName, "public_findings_count", regexp.MustCompile(`^\d+$`)),
+					testAccCheckFindingExists(resourceName, "aws_sqs_queue.test", &findingID),
+				),
+			},
+			// The queue is analyzed after the analyzer is created, so refresh to count its finding.
+			{
+				Config: testAccAnalyzerFindingsCountsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "active_findings_count", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr(resourceName, "public_findings_count", regexp.MustCompile(`^[1-9]\d*$`)),
+				),
+			},
+		},
+	})
+}
+
+// This test can be run via the pattern: TestAccAWSAccessAnalyzer
+func testAccAnalyzer_RecreateSameName(t *testing.T) {
+	var analyzer1, analyzer2 accessanalyzer.AnalyzerSummary
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_analyzer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalyzerAnalyzerNameConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalyzerExists(resourceName, &analyzer1),
+				),
+			},
+			{
+				// Tainting replaces the analyzer, deleting it and creating one
+				// with the same name in the same apply.
+				Config: testAccAnalyzerAnalyzerNameConfig(rName),
+				Taint:  []string{resourceName},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalyzerExists(resourceName, &analyzer2),
+					testAccCheckAnalyzerRecreated(&analyzer1, &analyzer2),
+					resource.TestCheckResourceAttr(resourceName, "analyzer_name", rName),
+				),
+			},
+		},
+	})
+}
+
+// This test can be run via the pattern: TestAccAWSAccessAnalyzer
+func testAccAnalyzer_DeletionProtection(t *testing.T) {
+	var analyzer accessanalyzer.AnalyzerSummary
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_analyzer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalyzerDeletionProtectionConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalyzerExists(resourceName, &analyzer),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccAnalyzerDeletionProtectionConfig(rName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`deletion protection is enabled`),
+			},
+			{
+				Config: testAccAnalyzerDeletionProtectionConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalyzerExists(resourceName, &analyzer),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 // This test can be run via the pattern: TestAccAWSAccessAnalyzer
 func testAccAnalyzer_disappears(t *testing.T) {
 	var analyzer accessanalyzer.AnalyzerSummary
@@ -71,6 +178,58 @@ func testAccAnalyzer_disappears(t *testing.T) {
 	})
 }
 
+// This test can be run via the pattern: TestAccAWSAccessAnalyzer
+func testAccAnalyzer_ImportInvalidName(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_analyzer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalyzerAnalyzerNameConfig(rName),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "1-invalid name",
+				ExpectError:   regexp.MustCompile(`invalid Access Analyzer Analyzer name \(1-invalid name\) for import: must begin with a letter`),
+			},
+		},
+	})
+}
+
+func testAccAnalyzer_NamePrefix(t *testing.T) {
+	var analyzer accessanalyzer.AnalyzerSummary
+
+	resourceName := "aws_accessanalyzer_analyzer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalyzerNamePrefixConfig("tf-acc-test-prefix-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalyzerExists(resourceName, &analyzer),
+					create.TestCheckResourceAttrNameFromPrefix(resourceName, "analyzer_name", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 // This test can be run via the pattern: TestAccAWSAccessAnalyzer
 func testAccAnalyzer_Tags(t *testing.T) {
 	var analyzer accessanalyzer.AnalyzerSummary
@@ -118,6 +277,35 @@ func testAccAnalyzer_Tags(t *testing.T) {
 	})
 }
 
+// This test can be run via the pattern: TestAccAWSAccessAnalyzer
+func testAccAnalyzer_Timeouts(t *testing.T) {
+	var analyzer accessanalyzer.AnalyzerSummary
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_analyzer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalyzerTimeoutsConfig(rName, "15m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalyzerExists(resourceName, &analyzer),
+					resource.TestCheckResourceAttr(resourceName, "analyzer_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 // This test can be run via the pattern: TestAccAWSAccessAnalyzer
 func testAccAnalyzer_Type_Organization(t *testing.T) {
 	var analyzer accessanalyzer.AnalyzerSummary
@@ -225,6 +413,16 @@ func testAccCheckAnalyzerExists(resourceName string, analyzer *accessanalyzer.An
 	}
 }
 
+func testAccCheckAnalyzerRecreated(before, after *accessanalyzer.AnalyzerSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.TimeValue(before.CreatedAt).Equal(aws.TimeValue(after.CreatedAt)) {
+			return fmt.Errorf("Access Analyzer Analyzer (%s) not recreated", aws.StringValue(before.Name))
+		}
+
+		return nil
+	}
+}
+
 func testAccAnalyzerAnalyzerNameConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_accessanalyzer_analyzer" "test" {
@@ -233,6 +431,23 @@ resource "aws_accessanalyzer_analyzer" "test" {
 `, rName)
 }
 
+func testAccAnalyzerDeletionProtectionConfig(rName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name       = %[1]q
+  deletion_protection = %[2]t
+}
+`, rName, deletionProtection)
+}
+
+func testAccAnalyzerNamePrefixConfig(namePrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  name_prefix = %[1]q
+}
+`, namePrefix)
+}
+
 func testAccAnalyzerTags1Config(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_accessanalyzer_analyzer" "test" {
@@ -245,6 +460,37 @@ resource "aws_accessanalyzer_analyzer" "test" {
 `, rName, tagKey1, tagValue1)
 }
 
+func testAccAnalyzerFindingsCountsConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name  = %[1]q
+  count_findings = true
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "sqs:SendMessage"
+      Resource  = "arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s"
+    }]
+  })
+
+  depends_on = [aws_accessanalyzer_analyzer.test]
+}
+`, rName)
+}
+
 func testAccAnalyzerTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
 	return fmt.Sprintf(`
 resource "aws_accessanalyzer_analyzer" "test" {
@@ -258,6 +504,18 @@ resource "aws_accessanalyzer_analyzer" "test" {
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
 
+func testAccAnalyzerTimeoutsConfig(rName, createTimeout string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+
+  timeouts {
+    create = %[2]q
+  }
+}
+`, rName, createTimeout)
+}
+
 func testAccAnalyzerTypeOrganizationConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -0,0 +1,82 @@
+package accessanalyzer
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestResourceAnalyzerTypeDiff(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		StateType     string
+		ConfigType    string
+		ExpectWarning bool
+	}{
+		{
+			Name:          "account to organization",
+			StateType:     accessanalyzer.TypeAccount,
+			ConfigType:    accessanalyzer.TypeOrganization,
+			ExpectWarning: true,
+		},
+		{
+			Name:          "organization to account",
+			StateType:     accessanalyzer.TypeOrganization,
+			ConfigType:    accessanalyzer.TypeAccount,
+			ExpectWarning: true,
+		},
+		{
+			Name:       "unchanged",
+			StateType:  accessanalyzer.TypeOrganization,
+			ConfigType: accessanalyzer.TypeOrganization,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var buf bytes.Buffer
+			log.SetOutput(&buf)
+			defer log.SetOutput(os.Stderr)
+
+			state := &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"analyzer_name":       "test",
+					"deletion_protection": "false",
+					"id":                  "test",
+					"name_prefix":         "",
+					"tags.%":              "0",
+					"tags_all.%":          "0",
+					"type":                testCase.StateType,
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"analyzer_name": "test",
+				"type":          testCase.ConfigType,
+			})
+
+			diff, err := ResourceAnalyzer().Diff(context.Background(), state, config, &conns.AWSClient{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := diff != nil && diff.RequiresNew(); got != testCase.ExpectWarning {
+				t.Errorf("got RequiresNew %t, expected %t", got, testCase.ExpectWarning)
+			}
+
+			warning := analyzerTypeChangeWarning("test", testCase.StateType, testCase.ConfigType)
+
+			if got := strings.Contains(buf.String(), warning); got != testCase.ExpectWarning {
+				t.Errorf("got warning logged %t, expected %t: %s", got, testCase.ExpectWarning, buf.String())
+			}
+		})
+	}
+}
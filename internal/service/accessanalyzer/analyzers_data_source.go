@@ -0,0 +1,96 @@
+package accessanalyzer
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceAnalyzers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAnalyzersRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.AnalyzerStatus_Values(), false),
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceAnalyzersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS()
+	status := d.Get("status").(string)
+
+	input := &accessanalyzer.ListAnalyzersInput{}
+
+	var results []*accessanalyzer.AnalyzerSummary
+
+	err := conn.ListAnalyzersPages(input, func(page *accessanalyzer.ListAnalyzersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, analyzer := range page.Analyzers {
+			if analyzer == nil {
+				continue
+			}
+
+			// The analyzer summaries returned by ListAnalyzers include the same status and tags as GetAnalyzer.
+			if status != "" && aws.StringValue(analyzer.Status) != status {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 && !KeyValueTags(analyzer.Tags).ContainsAll(tagsToMatch) {
+				continue
+			}
+
+			results = append(results, analyzer)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Access Analyzer Analyzers: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	var arns, names []string
+
+	for _, r := range results {
+		arns = append(arns, aws.StringValue(r.Arn))
+		names = append(names, aws.StringValue(r.Name))
+	}
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %w", err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+)
+
+func TestAnalyzersDataSourceRead_status(t *testing.T) {
+	conn := tfaccessanalyzer.NewMockConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *accessanalyzer.ListAnalyzersOutput:
+			data.Analyzers = []*accessanalyzer.AnalyzerSummary{
+				{Arn: aws.String("arn:aws:access-analyzer:us-west-2:123456789012:analyzer/active"), Name: aws.String("active"), Status: aws.String(accessanalyzer.AnalyzerStatusActive)},       //lintignore:AWSAT003,AWSAT005
+				{Arn: aws.String("arn:aws:access-analyzer:us-west-2:123456789012:analyzer/failed"), Name: aws.String("failed"), Status: aws.String(accessanalyzer.AnalyzerStatusFailed)},       //lintignore:AWSAT003,AWSAT005
+				{Arn: aws.String("arn:aws:access-analyzer:us-west-2:123456789012:analyzer/disabled"), Name: aws.String("disabled"), Status: aws.String(accessanalyzer.AnalyzerStatusDisabled)}, //lintignore:AWSAT003,AWSAT005
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	testCases := []struct {
+		Name          string
+		Status        string
+		ExpectedNames []interface{}
+	}{
+		{
+			Name:          "no filter",
+			ExpectedNames: []interface{}{"active", "failed", "disabled"},
+		},
+		{
+			Name:          "failed",
+			Status:        accessanalyzer.AnalyzerStatusFailed,
+			ExpectedNames: []interface{}{"failed"},
+		},
+		{
+			Name:          "no match",
+			Status:        accessanalyzer.AnalyzerStatusCreating,
+			ExpectedNames: []interface{}{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := tfaccessanalyzer.DataSourceAnalyzers()
+			d := r.TestResourceData()
+			d.Set("status", testCase.Status)
+
+			if err := r.Read(d, &conns.AWSClient{AccessAnalyzerConn: conn, Region: "us-west-2"}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Get("names").([]interface{}); !reflect.DeepEqual(got, testCase.ExpectedNames) {
+				t.Errorf("got names %v, expected %v", got, testCase.ExpectedNames)
+			}
+
+			if got, expected := len(d.Get("arns").([]interface{})), len(testCase.ExpectedNames); got != expected {
+				t.Errorf("got %d arns, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+// This test can be run via the pattern: TestAccAccessAnalyzer_serial
+func testAccAnalyzersDataSource_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_analyzer.test"
+	dataSourceName := "data.aws_accessanalyzer_analyzers.test"
+	noMatchDataSourceName := "data.aws_accessanalyzer_analyzers.no_match"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalyzersDataSourceTagsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, "analyzer_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(noMatchDataSourceName, "names.#", "0"),
+					resource.TestCheckResourceAttr(noMatchDataSourceName, "arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// This test can be run via the pattern: TestAccAccessAnalyzer_serial
+func testAccAnalyzersDataSource_status(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_analyzer.test"
+	dataSourceName := "data.aws_accessanalyzer_analyzers.test"
+	noMatchDataSourceName := "data.aws_accessanalyzer_analyzers.no_match"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalyzersDataSourceStatusConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, "analyzer_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(noMatchDataSourceName, "names.#", "0"),
+					resource.TestCheckResourceAttr(noMatchDataSourceName, "arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAnalyzersDataSourceTagsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_accessanalyzer_analyzers" "test" {
+  tags = {
+    Name = aws_accessanalyzer_analyzer.test.tags["Name"]
+  }
+}
+
+data "aws_accessanalyzer_analyzers" "no_match" {
+  tags = {
+    Name = "${aws_accessanalyzer_analyzer.test.tags["Name"]}-no-match"
+  }
+}
+`, rName)
+}
+
+func testAccAnalyzersDataSourceStatusConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_accessanalyzer_analyzers" "test" {
+  status = "ACTIVE"
+
+  tags = {
+    Name = aws_accessanalyzer_analyzer.test.tags["Name"]
+  }
+}
+
+data "aws_accessanalyzer_analyzers" "no_match" {
+  status = "FAILED"
+
+  tags = {
+    Name = aws_accessanalyzer_analyzer.test.tags["Name"]
+  }
+}
+`, rName)
+}
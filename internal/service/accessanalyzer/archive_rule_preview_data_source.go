@@ -0,0 +1,141 @@
+package accessanalyzer
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceArchiveRulePreview() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceArchiveRulePreviewRead,
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"criteria": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"eq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exists": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						"neq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"matching_finding_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArchiveRulePreviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	filter := expandArchiveRulePreviewFilter(d.Get("filter").([]interface{}))
+
+	// An archive rule only archives active findings, unless the rule itself filters on status.
+	if _, ok := filter["status"]; !ok {
+		filter["status"] = &accessanalyzer.Criterion{
+			Eq: aws.StringSlice([]string{accessanalyzer.FindingStatusActive}),
+		}
+	}
+
+	input := &accessanalyzer.ListFindingsInput{
+		AnalyzerArn: aws.String(analyzerARN),
+		Filter:      filter,
+	}
+
+	var count int
+
+	err := conn.ListFindingsPagesWithContext(ctx, input, func(page *accessanalyzer.ListFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		count += len(page.Findings)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("error listing Access Analyzer Findings for Analyzer (%s): %s", analyzerARN, err)
+	}
+
+	d.SetId(analyzerARN)
+	d.Set("matching_finding_count", count)
+
+	return nil
+}
+
+func expandArchiveRulePreviewFilter(tfList []interface{}) map[string]*accessanalyzer.Criterion {
+	apiObject := make(map[string]*accessanalyzer.Criterion)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		criterion := &accessanalyzer.Criterion{}
+
+		if v, ok := tfMap["contains"].([]interface{}); ok && len(v) > 0 {
+			criterion.Contains = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["eq"].([]interface{}); ok && len(v) > 0 {
+			criterion.Eq = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["exists"].(string); ok && v != "" {
+			exists, _ := strconv.ParseBool(v)
+			criterion.Exists = aws.Bool(exists)
+		}
+
+		if v, ok := tfMap["neq"].([]interface{}); ok && len(v) > 0 {
+			criterion.Neq = flex.ExpandStringList(v)
+		}
+
+		apiObject[tfMap["criteria"].(string)] = criterion
+	}
+
+	return apiObject
+}
@@ -0,0 +1,153 @@
+package accessanalyzer_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+)
+
+func TestArchiveRulePreviewDataSourceRead(t *testing.T) {
+	var filters []map[string]*accessanalyzer.Criterion
+	conn := tfaccessanalyzer.NewMockConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *accessanalyzer.ListFindingsOutput:
+			input := r.Params.(*accessanalyzer.ListFindingsInput)
+
+			if input.NextToken == nil {
+				filters = append(filters, input.Filter)
+				data.Findings = []*accessanalyzer.FindingSummary{{Id: aws.String("1")}, {Id: aws.String("2")}}
+				data.NextToken = aws.String("page2")
+				return
+			}
+
+			data.Findings = []*accessanalyzer.FindingSummary{{Id: aws.String("3")}}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	testCases := []struct {
+		Name           string
+		Filter         []interface{}
+		ExpectedFilter map[string]*accessanalyzer.Criterion
+	}{
+		{
+			Name: "active findings by default",
+			Filter: []interface{}{
+				map[string]interface{}{"criteria": "isPublic", "eq": []interface{}{"true"}},
+				map[string]interface{}{"criteria": "resourceType", "neq": []interface{}{"AWS::S3::Bucket"}},
+				map[string]interface{}{"criteria": "principal.AWS", "exists": "false"},
+			},
+			ExpectedFilter: map[string]*accessanalyzer.Criterion{
+				"isPublic":      {Eq: aws.StringSlice([]string{"true"})},
+				"resourceType":  {Neq: aws.StringSlice([]string{"AWS::S3::Bucket"})},
+				"principal.AWS": {Exists: aws.Bool(false)},
+				"status":        {Eq: aws.StringSlice([]string{accessanalyzer.FindingStatusActive})},
+			},
+		},
+		{
+			Name: "explicit status",
+			Filter: []interface{}{
+				map[string]interface{}{"criteria": "status", "eq": []interface{}{accessanalyzer.FindingStatusArchived}},
+				map[string]interface{}{"criteria": "condition.aws:SourceVpc", "contains": []interface{}{"vpc-"}},
+			},
+			ExpectedFilter: map[string]*accessanalyzer.Criterion{
+				"status":                  {Eq: aws.StringSlice([]string{accessanalyzer.FindingStatusArchived})},
+				"condition.aws:SourceVpc": {Contains: aws.StringSlice([]string{"vpc-"})},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			filters = nil
+
+			r := tfaccessanalyzer.DataSourceArchiveRulePreview()
+			d := r.TestResourceData()
+			d.Set("analyzer_arn", "arn:aws:access-analyzer:us-west-2:123456789012:analyzer/test") //lintignore:AWSAT003,AWSAT005
+			d.Set("filter", testCase.Filter)
+
+			if diags := r.ReadContext(context.Background(), d, &conns.AWSClient{AccessAnalyzerConn: conn}); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := d.Get("matching_finding_count").(int), 3; got != want {
+				t.Errorf("got matching_finding_count %d, expected %d", got, want)
+			}
+
+			if len(filters) != 1 {
+				t.Fatalf("got %d ListFindings listings, expected 1", len(filters))
+			}
+
+			if !reflect.DeepEqual(filters[0], testCase.ExpectedFilter) {
+				t.Errorf("got filter %v, expected %v", filters[0], testCase.ExpectedFilter)
+			}
+		})
+	}
+}
+
+// This test can be run via the pattern: TestAccAccessAnalyzer_serial
+func testAccArchiveRulePreviewDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	analyzerResourceName := "aws_accessanalyzer_analyzer.test"
+	queueResourceName := "aws_sqs_queue.test"
+	dataSourceName := "data.aws_accessanalyzer_archive_rule_preview.test"
+	var findingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckFinding(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingDataSourceBaseConfig(rName),
+				Check:  testAccCheckFindingExists(analyzerResourceName, queueResourceName, &findingID),
+			},
+			{
+				Config: testAccArchiveRulePreviewDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "matching_finding_count", "1"),
+					resource.TestCheckResourceAttr("data.aws_accessanalyzer_archive_rule_preview.no_match", "matching_finding_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccArchiveRulePreviewDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFindingDataSourceBaseConfig(rName), fmt.Sprintf(`
+data "aws_accessanalyzer_archive_rule_preview" "test" {
+  analyzer_arn = aws_accessanalyzer_analyzer.test.arn
+
+  filter {
+    criteria = "resource"
+    eq       = [aws_sqs_queue.test.arn]
+  }
+
+  filter {
+    criteria = "isPublic"
+    eq       = ["true"]
+  }
+}
+
+data "aws_accessanalyzer_archive_rule_preview" "no_match" {
+  analyzer_arn = aws_accessanalyzer_analyzer.test.arn
+
+  filter {
+    criteria = "resource"
+    eq       = ["${aws_sqs_queue.test.arn}-%[1]s"]
+  }
+}
+`, rName))
+}
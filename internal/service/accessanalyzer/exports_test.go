@@ -0,0 +1,6 @@
+package accessanalyzer
+
+// Exports for use in tests only.
+var (
+	NewMockConn = newMockAccessAnalyzerConn
+)
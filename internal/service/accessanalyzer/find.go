@@ -0,0 +1,119 @@
+package accessanalyzer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAnalyzerByName(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, name string) (*accessanalyzer.AnalyzerSummary, error) {
+	input := &accessanalyzer.GetAnalyzerInput{
+		AnalyzerName: aws.String(name),
+	}
+
+	output, err := conn.GetAnalyzerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Analyzer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Analyzer, nil
+}
+
+func FindFindingByAnalyzerARNAndID(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, analyzerARN, id string) (*accessanalyzer.Finding, error) {
+	input := &accessanalyzer.GetFindingInput{
+		AnalyzerArn: aws.String(analyzerARN),
+		Id:          aws.String(id),
+	}
+
+	output, err := conn.GetFindingWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Finding == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Finding, nil
+}
+
+func FindAnalyzedResourceByAnalyzerARNAndResourceARN(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, analyzerARN, resourceARN string) (*accessanalyzer.AnalyzedResource, error) {
+	input := &accessanalyzer.GetAnalyzedResourceInput{
+		AnalyzerArn: aws.String(analyzerARN),
+		ResourceArn: aws.String(resourceARN),
+	}
+
+	output, err := conn.GetAnalyzedResourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Resource == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Resource, nil
+}
+
+func FindFindings(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, input *accessanalyzer.ListFindingsInput) ([]*accessanalyzer.FindingSummary, error) {
+	var output []*accessanalyzer.FindingSummary
+
+	err := conn.ListFindingsPagesWithContext(ctx, input, func(page *accessanalyzer.ListFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Findings {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
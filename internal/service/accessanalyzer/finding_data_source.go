@@ -0,0 +1,83 @@
+package accessanalyzer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceFinding() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFindingRead,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"analyzer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"condition": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"resource": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	id := d.Get("id").(string)
+
+	finding, err := FindFindingByAnalyzerARNAndID(ctx, conn, analyzerARN, id)
+
+	if tfresource.NotFound(err) {
+		return diag.Errorf("no Access Analyzer Finding (%s) found for Analyzer (%s)", id, analyzerARN)
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Access Analyzer Finding (%s): %s", id, err)
+	}
+
+	d.SetId(aws.StringValue(finding.Id))
+	d.Set("action", aws.StringValueSlice(finding.Action))
+	d.Set("condition", aws.StringValueMap(finding.Condition))
+	d.Set("is_public", finding.IsPublic)
+	d.Set("resource", finding.Resource)
+	d.Set("resource_type", finding.ResourceType)
+	d.Set("status", finding.Status)
+
+	return nil
+}
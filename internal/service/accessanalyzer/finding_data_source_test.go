@@ -0,0 +1,182 @@
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Analysis of a newly created resource can take up to 30 minutes, so the
+// test only runs when explicitly requested.
+const findingDataSourceTimeout = 30 * time.Minute
+
+func testAccPreCheckFinding(t *testing.T) {
+	if os.Getenv("ACCESSANALYZER_FINDING_TESTS") == "" {
+		t.Skipf("Environment variable ACCESSANALYZER_FINDING_TESTS is not set; waiting for a finding can take up to %s", findingDataSourceTimeout)
+	}
+}
+
+// This test can be run via the pattern: TestAccAccessAnalyzer_serial
+func testAccFindingDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	analyzerResourceName := "aws_accessanalyzer_analyzer.test"
+	queueResourceName := "aws_sqs_queue.test"
+	dataSourceName := "data.aws_accessanalyzer_finding.test"
+	var findingID string
+
+	steps := []resource.TestStep{
+		{
+			Config: testAccFindingDataSourceBaseConfig(rName),
+			Check:  testAccCheckFindingExists(analyzerResourceName, queueResourceName, &findingID),
+		},
+		{
+			// Config is filled in once the finding ID is known.
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttrPair(dataSourceName, "analyzer_arn", analyzerResourceName, "arn"),
+				resource.TestCheckResourceAttrPair(dataSourceName, "resource", queueResourceName, "arn"),
+				resource.TestCheckResourceAttr(dataSourceName, "resource_type", accessanalyzer.ResourceTypeAwsSqsQueue),
+				resource.TestCheckResourceAttr(dataSourceName, "status", accessanalyzer.FindingStatusActive),
+				resource.TestCheckResourceAttr(dataSourceName, "is_public", "true"),
+				resource.TestCheckResourceAttr(dataSourceName, "action.#", "1"),
+				resource.TestCheckResourceAttr(dataSourceName, "action.0", "sqs:SendMessage"),
+			),
+		},
+	}
+
+	steps[0].Check = resource.ComposeTestCheckFunc(
+		steps[0].Check,
+		func(*terraform.State) error {
+			steps[1].Config = testAccFindingDataSourceConfig(rName, findingID)
+			return nil
+		},
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckFinding(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps:        steps,
+	})
+}
+
+// This test can be run via the pattern: TestAccAccessAnalyzer_serial
+func testAccFindingDataSource_notFound(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFindingDataSourceNotFoundConfig(rName),
+				ExpectError: regexp.MustCompile(`no Access Analyzer Finding \(00000000-0000-0000-0000-000000000000\) found`),
+			},
+		},
+	})
+}
+
+func testAccCheckFindingExists(analyzerResourceName, resourceName string, findingID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		analyzer, ok := s.RootModule().Resources[analyzerResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", analyzerResourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerConn
+
+		input := &accessanalyzer.ListFindingsInput{
+			AnalyzerArn: aws.String(analyzer.Primary.Attributes["arn"]),
+			Filter: map[string]*accessanalyzer.Criterion{
+				"resource": {
+					Eq: aws.StringSlice([]string{rs.Primary.Attributes["arn"]}),
+				},
+			},
+		}
+
+		return resource.Retry(findingDataSourceTimeout, func() *resource.RetryError {
+			output, err := conn.ListFindings(input)
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if output == nil || len(output.Findings) == 0 {
+				return resource.RetryableError(fmt.Errorf("no Access Analyzer Finding yet for %s", rs.Primary.Attributes["arn"]))
+			}
+
+			*findingID = aws.StringValue(output.Findings[0].Id)
+
+			return nil
+		})
+	}
+}
+
+func testAccFindingDataSourceBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "sqs:SendMessage"
+      Resource  = "arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s"
+    }]
+  })
+
+  depends_on = [aws_accessanalyzer_analyzer.test]
+}
+`, rName)
+}
+
+func testAccFindingDataSourceConfig(rName, findingID string) string {
+	return acctest.ConfigCompose(testAccFindingDataSourceBaseConfig(rName), fmt.Sprintf(`
+data "aws_accessanalyzer_finding" "test" {
+  analyzer_arn = aws_accessanalyzer_analyzer.test.arn
+  id           = %[1]q
+}
+`, findingID))
+}
+
+func testAccFindingDataSourceNotFoundConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+}
+
+data "aws_accessanalyzer_finding" "test" {
+  analyzer_arn = aws_accessanalyzer_analyzer.test.arn
+  id           = "00000000-0000-0000-0000-000000000000"
+}
+`, rName)
+}
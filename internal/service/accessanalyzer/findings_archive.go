@@ -0,0 +1,236 @@
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Default maximum amount of time to wait for archived findings to no longer be listed as active
+	findingsArchiveCreateTimeout = 5 * time.Minute
+)
+
+func ResourceFindingsArchive() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFindingsArchiveCreate,
+		ReadContext:   resourceFindingsArchiveRead,
+		DeleteContext: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(findingsArchiveCreateTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"archived_finding_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"archived_finding_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"criteria": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							// Only active findings are archived.
+							ValidateFunc: validation.StringNotInSlice([]string{"status"}, false),
+						},
+						"eq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exists": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						"neq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceFindingsArchiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	filter := expandArchiveRulePreviewFilter(d.Get("filter").([]interface{}))
+	filter["status"] = &accessanalyzer.Criterion{
+		Eq: aws.StringSlice([]string{accessanalyzer.FindingStatusActive}),
+	}
+
+	listInput := &accessanalyzer.ListFindingsInput{
+		AnalyzerArn: aws.String(analyzerARN),
+		Filter:      filter,
+	}
+
+	findings, err := FindFindings(ctx, conn, listInput)
+
+	if err != nil {
+		return diag.Errorf("error listing Access Analyzer Findings for Analyzer (%s): %s", analyzerARN, err)
+	}
+
+	var ids []string
+
+	for _, finding := range findings {
+		ids = append(ids, aws.StringValue(finding.Id))
+	}
+
+	// Findings archived by a previous run are no longer active, so are not archived again.
+	if len(ids) > 0 {
+		input := &accessanalyzer.UpdateFindingsInput{
+			AnalyzerArn: aws.String(analyzerARN),
+			ClientToken: aws.String(resource.UniqueId()),
+			Ids:         aws.StringSlice(ids),
+			Status:      aws.String(accessanalyzer.FindingStatusUpdateArchived),
+		}
+
+		log.Printf("[DEBUG] Archiving %d Access Analyzer Findings for Analyzer (%s)", len(ids), analyzerARN)
+		if _, err := conn.UpdateFindingsWithContext(ctx, input); err != nil {
+			return diag.Errorf("error archiving Access Analyzer Findings for Analyzer (%s): %s", analyzerARN, err)
+		}
+
+		// Re-check the status until none of the archived findings is listed as active.
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			err := findingsArchived(ctx, conn, listInput, ids)
+
+			if tfresource.NotFound(err) {
+				return resource.NonRetryableError(err)
+			}
+
+			if err != nil {
+				return resource.RetryableError(err)
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			err = findingsArchived(ctx, conn, listInput, ids)
+		}
+
+		if err != nil {
+			return diag.Errorf("error waiting for Access Analyzer Findings for Analyzer (%s) to be archived: %s", analyzerARN, err)
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("archived_finding_count", len(ids))
+
+	if err := d.Set("archived_finding_ids", ids); err != nil {
+		return diag.Errorf("error setting archived_finding_ids: %s", err)
+	}
+
+	return resourceFindingsArchiveRead(ctx, d, meta)
+}
+
+func resourceFindingsArchiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	name, err := analyzerNameFromARN(analyzerARN)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = FindAnalyzerByName(ctx, conn, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Access Analyzer Analyzer (%s) not found, removing Findings Archive (%s) from state", analyzerARN, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Access Analyzer Analyzer (%s): %s", analyzerARN, err)
+	}
+
+	return nil
+}
+
+// findingsArchived returns an error if any of ids is still listed by input.
+func findingsArchived(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, input *accessanalyzer.ListFindingsInput, ids []string) error {
+	findings, err := FindFindings(ctx, conn, input)
+
+	if err != nil {
+		return err
+	}
+
+	archived := make(map[string]bool, len(ids))
+
+	for _, id := range ids {
+		archived[id] = true
+	}
+
+	for _, finding := range findings {
+		if id := aws.StringValue(finding.Id); archived[id] {
+			return fmt.Errorf("Access Analyzer Finding (%s) is still %s", id, aws.StringValue(finding.Status))
+		}
+	}
+
+	return nil
+}
+
+// analyzerNameFromARN parses the name of an analyzer from its ARN,
+// e.g. arn:aws:access-analyzer:us-west-2:123456789012:analyzer/NAME.
+func analyzerNameFromARN(s string) (string, error) {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing Access Analyzer Analyzer ARN (%s): %w", s, err)
+	}
+
+	name := strings.TrimPrefix(parsedARN.Resource, "analyzer/")
+
+	if name == parsedARN.Resource || name == "" {
+		return "", fmt.Errorf("unexpected format of Access Analyzer Analyzer ARN (%s), expected arn:PARTITION:access-analyzer:REGION:ACCOUNT:analyzer/NAME", s)
+	}
+
+	return name, nil
+}
@@ -0,0 +1,229 @@
+package accessanalyzer_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+)
+
+func TestFindingsArchiveCreate(t *testing.T) {
+	analyzerARN := "arn:aws:access-analyzer:us-west-2:123456789012:analyzer/test" //lintignore:AWSAT003,AWSAT005
+
+	var mu sync.Mutex
+	statuses := map[string]string{
+		"1": accessanalyzer.FindingStatusActive,
+		"2": accessanalyzer.FindingStatusActive,
+		"3": accessanalyzer.FindingStatusArchived,
+	}
+	var updates [][]string
+	// The first listing after archiving still reports the findings as active.
+	var staleListings int
+
+	conn := tfaccessanalyzer.NewMockConn(t, func(r *request.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch data := r.Data.(type) {
+		case *accessanalyzer.ListFindingsOutput:
+			input := r.Params.(*accessanalyzer.ListFindingsInput)
+
+			if got, expected := aws.StringValue(input.AnalyzerArn), analyzerARN; got != expected {
+				t.Errorf("got AnalyzerArn %s, expected %s", got, expected)
+			}
+
+			if expected := aws.StringSlice([]string{accessanalyzer.FindingStatusActive}); !reflect.DeepEqual(input.Filter["status"].Eq, expected) {
+				t.Errorf("got status filter %v, expected only active findings to be listed", input.Filter["status"])
+			}
+
+			for _, id := range []string{"1", "2", "3"} {
+				status := statuses[id]
+
+				if staleListings > 0 && status == accessanalyzer.FindingStatusArchived && id != "3" {
+					status = accessanalyzer.FindingStatusActive
+				}
+
+				if status == accessanalyzer.FindingStatusActive {
+					data.Findings = append(data.Findings, &accessanalyzer.FindingSummary{
+						Id:     aws.String(id),
+						Status: aws.String(status),
+					})
+				}
+			}
+
+			if staleListings > 0 {
+				staleListings--
+			}
+		case *accessanalyzer.UpdateFindingsOutput:
+			input := r.Params.(*accessanalyzer.UpdateFindingsInput)
+
+			if got, expected := aws.StringValue(input.Status), accessanalyzer.FindingStatusUpdateArchived; got != expected {
+				t.Errorf("got Status %s, expected %s", got, expected)
+			}
+
+			ids := aws.StringValueSlice(input.Ids)
+			updates = append(updates, ids)
+
+			for _, id := range ids {
+				statuses[id] = accessanalyzer.FindingStatusArchived
+			}
+
+			staleListings = 1
+		case *accessanalyzer.GetAnalyzerOutput:
+			data.Analyzer = &accessanalyzer.AnalyzerSummary{
+				Arn:    aws.String(analyzerARN),
+				Name:   aws.String("test"),
+				Status: aws.String(accessanalyzer.AnalyzerStatusActive),
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{AccessAnalyzerConn: conn}
+	r := tfaccessanalyzer.ResourceFindingsArchive()
+
+	create := func() *schema.ResourceData {
+		d := r.TestResourceData()
+		d.Set("analyzer_arn", analyzerARN)
+		d.Set("filter", []interface{}{
+			map[string]interface{}{"criteria": "resourceType", "eq": []interface{}{accessanalyzer.ResourceTypeAwsSqsQueue}},
+		})
+
+		if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		return d
+	}
+
+	d := create()
+
+	if d.Id() == "" {
+		t.Fatal("expected ID to be set")
+	}
+
+	if got, expected := d.Get("archived_finding_count").(int), 2; got != expected {
+		t.Errorf("got archived_finding_count %d, expected %d", got, expected)
+	}
+
+	var ids []string
+	for _, v := range d.Get("archived_finding_ids").(*schema.Set).List() {
+		ids = append(ids, v.(string))
+	}
+	sort.Strings(ids)
+
+	if expected := []string{"1", "2"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("got archived_finding_ids %q, expected %q", ids, expected)
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("got %d UpdateFindings calls, expected 1", len(updates))
+	}
+
+	// Running again finds nothing left to archive.
+	d = create()
+
+	if got := d.Get("archived_finding_count").(int); got != 0 {
+		t.Errorf("got archived_finding_count %d on the second run, expected 0", got)
+	}
+
+	if len(updates) != 1 {
+		t.Errorf("got %d UpdateFindings calls, expected findings not to be archived again", len(updates))
+	}
+}
+
+func TestFindingsArchiveValidate_statusCriteria(t *testing.T) {
+	r := tfaccessanalyzer.ResourceFindingsArchive()
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"analyzer_arn": "arn:aws:access-analyzer:us-west-2:123456789012:analyzer/test", //lintignore:AWSAT003,AWSAT005
+		"filter": []interface{}{
+			map[string]interface{}{"criteria": "status", "eq": []interface{}{accessanalyzer.FindingStatusArchived}},
+		},
+	}))
+
+	if !diags.HasError() {
+		t.Error("expected error filtering on status, got none")
+	}
+}
+
+// This test can be run via the pattern: TestAccAccessAnalyzer_serial
+func testAccFindingsArchive_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	analyzerResourceName := "aws_accessanalyzer_analyzer.test"
+	queueResourceName := "aws_sqs_queue.test"
+	resourceName := "aws_accessanalyzer_findings_archive.test"
+	var findingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckFinding(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessAnalyzerAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingDataSourceBaseConfig(rName),
+				Check:  testAccCheckFindingExists(analyzerResourceName, queueResourceName, &findingID),
+			},
+			{
+				Config: testAccFindingsArchiveConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "analyzer_arn", analyzerResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "archived_finding_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "archived_finding_ids.#", "1"),
+					testAccCheckFindingStatus(analyzerResourceName, &findingID, accessanalyzer.FindingStatusArchived),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFindingStatus(analyzerResourceName string, findingID *string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		analyzer, ok := s.RootModule().Resources[analyzerResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", analyzerResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerConn
+
+		finding, err := tfaccessanalyzer.FindFindingByAnalyzerARNAndID(context.Background(), conn, analyzer.Primary.Attributes["arn"], *findingID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(finding.Status); got != expected {
+			return fmt.Errorf("Access Analyzer Finding (%s) status is %s, expected %s", *findingID, got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccFindingsArchiveConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFindingDataSourceBaseConfig(rName), `
+resource "aws_accessanalyzer_findings_archive" "test" {
+  analyzer_arn = aws_accessanalyzer_analyzer.test.arn
+
+  filter {
+    criteria = "resource"
+    eq       = [aws_sqs_queue.test.arn]
+  }
+}
+`)
+}
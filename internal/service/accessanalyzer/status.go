@@ -0,0 +1,26 @@
+package accessanalyzer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusAnalyzer(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAnalyzerByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,22 @@
+package accessanalyzer
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Maximum amount of time to keep retrying a throttled tag update
+const tagsUpdateThrottleTimeout = 2 * time.Minute
+
+// updateTagsWithRetry calls UpdateTags, retrying with backoff while the request is throttled.
+// Untagging and tagging are idempotent, so the whole update is safe to repeat.
+func updateTagsWithRetry(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, tagsUpdateThrottleTimeout, func() (interface{}, error) {
+		return nil, UpdateTags(conn, identifier, oldTagsMap, newTagsMap)
+	}, accessanalyzer.ErrCodeThrottlingException)
+
+	return err
+}
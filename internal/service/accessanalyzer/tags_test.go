@@ -0,0 +1,194 @@
+package accessanalyzer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestUpdateTagsWithRetry(t *testing.T) {
+	var tagCalls int
+	conn := newMockAccessAnalyzerConn(t, func(r *request.Request) {
+		input, ok := r.Params.(*accessanalyzer.TagResourceInput)
+
+		if !ok {
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+			return
+		}
+
+		tagCalls++
+
+		if tagCalls == 1 {
+			r.Error = awserr.New(accessanalyzer.ErrCodeThrottlingException, "Rate exceeded", nil)
+			return
+		}
+
+		if got := aws.StringValue(input.Tags["key1"]); got != "value1" {
+			t.Errorf("got tag key1 = %s, expected value1", got)
+		}
+	})
+
+	err := updateTagsWithRetry(context.Background(), conn, "arn:aws:access-analyzer:us-west-2:123456789012:analyzer/test", map[string]interface{}{}, map[string]interface{}{"key1": "value1"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if tagCalls != 2 {
+		t.Errorf("got %d TagResource calls, expected 2", tagCalls)
+	}
+}
+
+func TestResourceAnalyzerUpdate_tagsWithoutARN(t *testing.T) {
+	expectedARN := "arn:aws-us-gov:access-analyzer:us-gov-west-1:123456789012:analyzer/test"
+
+	var taggedARN string
+	conn := newMockAccessAnalyzerConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *accessanalyzer.TagResourceOutput:
+			taggedARN = aws.StringValue(r.Params.(*accessanalyzer.TagResourceInput).ResourceArn)
+		case *accessanalyzer.GetAnalyzerOutput:
+			// Simulate the API omitting the analyzer ARN.
+			data.Analyzer = &accessanalyzer.AnalyzerSummary{
+				Name:   aws.String("test"),
+				Status: aws.String(accessanalyzer.AnalyzerStatusActive),
+				Tags:   aws.StringMap(map[string]string{"key1": "value1"}),
+				Type:   aws.String(accessanalyzer.TypeAccount),
+			}
+		case *accessanalyzer.ListFindingsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccessAnalyzerConn: conn,
+		AccountID:          "123456789012",
+		Partition:          "aws-us-gov",
+		Region:             "us-gov-west-1",
+	}
+
+	r := ResourceAnalyzer()
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"analyzer_name":       "test",
+			"arn":                 "",
+			"deletion_protection": "false",
+			"id":                  "test",
+			"name_prefix":         "",
+			"tags.%":              "0",
+			"tags_all.%":          "0",
+			"type":                accessanalyzer.TypeAccount,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"analyzer_name": "test",
+		"tags": map[string]interface{}{
+			"key1": "value1",
+		},
+	})
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if taggedARN != expectedARN {
+		t.Errorf("got TagResource ARN %s, expected %s", taggedARN, expectedARN)
+	}
+
+	if got := newState.Attributes["arn"]; got != expectedARN {
+		t.Errorf("got arn %s, expected %s", got, expectedARN)
+	}
+}
+
+func TestResourceAnalyzerUpdate_tagsStaleARN(t *testing.T) {
+	staleARN := "arn:aws:access-analyzer:us-east-1:123456789012:analyzer/test"
+	currentARN := "arn:aws:access-analyzer:us-west-2:123456789012:analyzer/test"
+
+	var taggedARNs []string
+	conn := newMockAccessAnalyzerConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *accessanalyzer.TagResourceOutput:
+			resourceARN := aws.StringValue(r.Params.(*accessanalyzer.TagResourceInput).ResourceArn)
+			taggedARNs = append(taggedARNs, resourceARN)
+
+			if resourceARN != currentARN {
+				r.Error = awserr.New(accessanalyzer.ErrCodeResourceNotFoundException, "Analyzer not found", nil)
+			}
+		case *accessanalyzer.GetAnalyzerOutput:
+			data.Analyzer = &accessanalyzer.AnalyzerSummary{
+				Arn:    aws.String(currentARN),
+				Name:   aws.String("test"),
+				Status: aws.String(accessanalyzer.AnalyzerStatusActive),
+				Tags:   aws.StringMap(map[string]string{"key1": "value1"}),
+				Type:   aws.String(accessanalyzer.TypeAccount),
+			}
+		case *accessanalyzer.ListFindingsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccessAnalyzerConn: conn,
+		AccountID:          "123456789012",
+		Partition:          "aws",
+		Region:             "us-west-2",
+	}
+
+	r := ResourceAnalyzer()
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"analyzer_name":       "test",
+			"arn":                 staleARN,
+			"deletion_protection": "false",
+			"id":                  "test",
+			"name_prefix":         "",
+			"tags.%":              "0",
+			"tags_all.%":          "0",
+			"type":                accessanalyzer.TypeAccount,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"analyzer_name": "test",
+		"tags": map[string]interface{}{
+			"key1": "value1",
+		},
+	})
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(taggedARNs) != 1 || taggedARNs[0] != currentARN {
+		t.Errorf("got TagResource ARNs %v, expected [%s]", taggedARNs, currentARN)
+	}
+
+	if got := newState.Attributes["arn"]; got != currentARN {
+		t.Errorf("got arn %s, expected %s", got, currentARN)
+	}
+}
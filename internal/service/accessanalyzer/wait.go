@@ -0,0 +1,75 @@
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitAnalyzerCreated(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, name string, timeout time.Duration) (*accessanalyzer.AnalyzerSummary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{accessanalyzer.AnalyzerStatusCreating},
+		Target:  []string{accessanalyzer.AnalyzerStatusActive},
+		Refresh: statusAnalyzer(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*accessanalyzer.AnalyzerSummary); ok {
+		if aws.StringValue(output.Status) == accessanalyzer.AnalyzerStatusFailed {
+			tfresource.SetLastError(err, analyzerStatusReasonError(output.StatusReason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitAnalyzerDeleted waits until GetAnalyzer no longer finds the analyzer, so
+// that an analyzer with the same name can be created straight away.
+func waitAnalyzerDeleted(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, name string, timeout time.Duration) (*accessanalyzer.AnalyzerSummary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: accessanalyzer.AnalyzerStatus_Values(),
+		Target:  []string{},
+		Refresh: statusAnalyzer(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*accessanalyzer.AnalyzerSummary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// analyzerStatusReasonError returns an error describing why an analyzer failed,
+// including a hint on how to resolve the most common causes.
+func analyzerStatusReasonError(statusReason *accessanalyzer.StatusReason) error {
+	if statusReason == nil {
+		return nil
+	}
+
+	code := aws.StringValue(statusReason.Code)
+
+	switch code {
+	case accessanalyzer.ReasonCodeAwsServiceAccessDisabled:
+		return fmt.Errorf("%s: trusted access for Access Analyzer is not enabled in AWS Organizations, enable it for the access-analyzer service principal", code)
+	case accessanalyzer.ReasonCodeDelegatedAdministratorDeregistered:
+		return fmt.Errorf("%s: this account is no longer a delegated administrator for Access Analyzer, register it again or create the analyzer from the organization management account", code)
+	case accessanalyzer.ReasonCodeOrganizationDeleted:
+		return fmt.Errorf("%s: the AWS Organization this analyzer belongs to was deleted", code)
+	case accessanalyzer.ReasonCodeServiceLinkedRoleCreationFailed:
+		return fmt.Errorf("%s: the AWSServiceRoleForAccessAnalyzer service-linked role could not be created, check that the caller has iam:CreateServiceLinkedRole permission", code)
+	}
+
+	return fmt.Errorf("%s", code)
+}
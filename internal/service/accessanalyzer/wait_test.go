@@ -0,0 +1,80 @@
+package accessanalyzer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+)
+
+func TestAnalyzerStatusReasonError(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Analyzer     *accessanalyzer.AnalyzerSummary
+		ExpectedText []string
+	}{
+		{
+			Name: "no reason",
+			Analyzer: &accessanalyzer.AnalyzerSummary{
+				Status: aws.String(accessanalyzer.AnalyzerStatusFailed),
+			},
+		},
+		{
+			Name: "service-linked role creation failed",
+			Analyzer: &accessanalyzer.AnalyzerSummary{
+				Status: aws.String(accessanalyzer.AnalyzerStatusFailed),
+				StatusReason: &accessanalyzer.StatusReason{
+					Code: aws.String(accessanalyzer.ReasonCodeServiceLinkedRoleCreationFailed),
+				},
+			},
+			ExpectedText: []string{accessanalyzer.ReasonCodeServiceLinkedRoleCreationFailed, "iam:CreateServiceLinkedRole"},
+		},
+		{
+			Name: "service access disabled",
+			Analyzer: &accessanalyzer.AnalyzerSummary{
+				Status: aws.String(accessanalyzer.AnalyzerStatusFailed),
+				StatusReason: &accessanalyzer.StatusReason{
+					Code: aws.String(accessanalyzer.ReasonCodeAwsServiceAccessDisabled),
+				},
+			},
+			ExpectedText: []string{accessanalyzer.ReasonCodeAwsServiceAccessDisabled, "trusted access"},
+		},
+		{
+			Name: "unknown reason",
+			Analyzer: &accessanalyzer.AnalyzerSummary{
+				Status: aws.String(accessanalyzer.AnalyzerStatusFailed),
+				StatusReason: &accessanalyzer.StatusReason{
+					Code: aws.String("NEW_REASON"),
+				},
+			},
+			ExpectedText: []string{"NEW_REASON"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := analyzerStatusReasonError(testCase.Analyzer.StatusReason)
+
+			if len(testCase.ExpectedText) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, text := range testCase.ExpectedText {
+				if !strings.Contains(err.Error(), text) {
+					t.Errorf("expected error %q to contain %q", err, text)
+				}
+			}
+		})
+	}
+}
@@ -2,12 +2,41 @@ package cognitoidp
 
 import (
 	"fmt"
+	"log"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	errCodeAccessDeniedException = "AccessDeniedException"
+
+	// Client count of a user pool whose clients cannot be listed
+	userPoolClientCountUnknown = -1
+
+	// Maximum amount of time to keep retrying a throttled user pool client listing
+	listUserPoolClientsThrottleTimeout = 2 * time.Minute
+
+	// Maximum amount of time to keep retrying a throttled user pool description
+	describeUserPoolThrottleTimeout = 2 * time.Minute
+
+	// Maximum amount of time to keep retrying a throttled user pool MFA configuration read
+	getUserPoolMfaConfigThrottleTimeout = 2 * time.Minute
+
+	// Maximum amount of time to keep retrying a throttled user pool tag listing
+	listTagsForResourceThrottleTimeout = 2 * time.Minute
+
+	// Maximum number of pages read by a single user pool or client listing,
+	// guarding against a pager that never ends.
+	listUserPoolsMaxPages = 1000
 )
 
 func DataSourceUserPools() *schema.Resource {
@@ -20,21 +49,93 @@ func DataSourceUserPools() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"client_counts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"creation_dates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"include_client_counts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"include_domains": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"include_mfa_configurations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"include_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"mfa_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"tags_all": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
 		},
 	}
 }
 
 func dataSourceUserPoolsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	client := meta.(*conns.AWSClient)
+	conn := client.CognitoIDPConn
 
 	output, err := findUserPoolDescriptionTypes(conn)
 
@@ -42,41 +143,373 @@ func dataSourceUserPoolsRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading Cognito User Pools: %w", err)
 	}
 
+	var createdAfter, createdBefore time.Time
+
+	if v, ok := d.GetOk("created_after"); ok {
+		createdAfter, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	if v, ok := d.GetOk("created_before"); ok {
+		createdBefore, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
 	name := d.Get("name").(string)
-	var arns, userPoolIDs []string
+	mostRecent := d.Get("most_recent").(bool)
+	// Creation dates may need a describe call per pool, so they are only
+	// resolved when filtering or ordering on them.
+	needCreationDates := mostRecent || !createdAfter.IsZero() || !createdBefore.IsZero()
+	var pools []userPoolsDataSourcePool
 
 	for _, v := range output {
 		if name != aws.StringValue(v.Name) {
 			continue
 		}
 
+		var creationDate time.Time
+		var described *cognitoidentityprovider.UserPoolType
+
+		if needCreationDates {
+			creationDate, described, err = userPoolCreationDate(conn, v)
+
+			if err != nil {
+				return fmt.Errorf("error reading Cognito User Pool (%s): %w", aws.StringValue(v.Id), err)
+			}
+
+			if !createdAfter.IsZero() && !creationDate.After(createdAfter) {
+				continue
+			}
+
+			if !createdBefore.IsZero() && !creationDate.Before(createdBefore) {
+				continue
+			}
+		}
+
 		userPoolID := aws.StringValue(v.Id)
-		arn := arn.ARN{
-			Partition: meta.(*conns.AWSClient).Partition,
-			Service:   cognitoidentityprovider.ServiceName,
-			Region:    meta.(*conns.AWSClient).Region,
-			AccountID: meta.(*conns.AWSClient).AccountID,
-			Resource:  fmt.Sprintf("userpool/%s", userPoolID),
-		}.String()
+		arn, err := client.RegionalARN(cognitoidentityprovider.ServiceName, fmt.Sprintf("userpool/%s", userPoolID))
+
+		if err != nil {
+			return fmt.Errorf("error reading Cognito User Pool (%s): %w", userPoolID, err)
+		}
+
+		pools = append(pools, userPoolsDataSourcePool{
+			arn:          arn,
+			creationDate: creationDate,
+			described:    described,
+			id:           userPoolID,
+		})
+	}
+
+	// Newest first, falling back to the ID so that pools created at the
+	// same time are still returned in a stable order.
+	if mostRecent {
+		sort.SliceStable(pools, func(i, j int) bool {
+			if !pools[i].creationDate.Equal(pools[j].creationDate) {
+				return pools[i].creationDate.After(pools[j].creationDate)
+			}
+
+			return pools[i].id < pools[j].id
+		})
+	}
+
+	var arns, creationDates, userPoolIDs []string
+
+	for _, pool := range pools {
+		userPoolIDs = append(userPoolIDs, pool.id)
+		arns = append(arns, pool.arn)
+
+		if needCreationDates {
+			creationDates = append(creationDates, pool.creationDate.Format(time.RFC3339))
+		}
+	}
+
+	// Counting clients makes a paginated call per pool, so it is opt-in.
+	if d.Get("include_client_counts").(bool) {
+		clientCounts := make([]int, 0, len(userPoolIDs))
+
+		for _, userPoolID := range userPoolIDs {
+			count, err := countUserPoolClients(conn, userPoolID)
+
+			// Listing clients needs an additional permission, which is not required to list the pools.
+			if tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+				log.Printf("[WARN] Unable to count Cognito User Pool (%s) clients: %s", userPoolID, err)
+				clientCounts = append(clientCounts, userPoolClientCountUnknown)
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error listing Cognito User Pool (%s) clients: %w", userPoolID, err)
+			}
+
+			clientCounts = append(clientCounts, count)
+		}
+
+		d.Set("client_counts", clientCounts)
+	} else {
+		d.Set("client_counts", nil)
+	}
+
+	// Reading domains may describe every pool, so it is opt-in.
+	if d.Get("include_domains").(bool) {
+		domains := make([]string, 0, len(pools))
+
+		for _, pool := range pools {
+			userPool := pool.described
+
+			// Pools already described for their creation date are not described again.
+			if userPool == nil {
+				userPool, err = describeUserPool(conn, pool.id)
+
+				// Describing the pool needs an additional permission, which is not required to list the pools.
+				if tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+					log.Printf("[WARN] Unable to read Cognito User Pool (%s) domain: %s", pool.id, err)
+					domains = append(domains, "")
+					continue
+				}
 
-		userPoolIDs = append(userPoolIDs, userPoolID)
-		arns = append(arns, arn)
+				if err != nil {
+					return fmt.Errorf("error reading Cognito User Pool (%s) domain: %w", pool.id, err)
+				}
+			}
+
+			domains = append(domains, userPoolDomain(userPool))
+		}
+
+		d.Set("domains", domains)
+	} else {
+		d.Set("domains", nil)
+	}
+
+	// Reading MFA configurations makes a call per pool, so it is opt-in.
+	if d.Get("include_mfa_configurations").(bool) {
+		mfaConfigurations := make([]string, 0, len(userPoolIDs))
+
+		for _, userPoolID := range userPoolIDs {
+			mfaConfiguration, err := userPoolMfaConfiguration(conn, userPoolID)
+
+			// Reading the MFA configuration needs an additional permission, which is not required to list the pools.
+			if tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+				log.Printf("[WARN] Unable to read Cognito User Pool (%s) MFA configuration: %s", userPoolID, err)
+				mfaConfigurations = append(mfaConfigurations, "")
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error reading Cognito User Pool (%s) MFA configuration: %w", userPoolID, err)
+			}
+
+			mfaConfigurations = append(mfaConfigurations, mfaConfiguration)
+		}
+
+		d.Set("mfa_configurations", mfaConfigurations)
+	} else {
+		d.Set("mfa_configurations", nil)
+	}
+
+	var tags, tagsAll []interface{}
+
+	// Reading tags makes a call per pool, so it is opt-in.
+	if d.Get("include_tags").(bool) {
+		tags = make([]interface{}, 0, len(arns))
+		tagsAll = make([]interface{}, 0, len(arns))
+
+		for i, arn := range arns {
+			poolTags, err := userPoolTags(conn, arn)
+
+			// Listing tags needs an additional permission, which is not required to list the pools.
+			if tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+				log.Printf("[WARN] Unable to list tags for Cognito User Pool (%s): %s", userPoolIDs[i], err)
+				tags = append(tags, map[string]interface{}{})
+				tagsAll = append(tagsAll, map[string]interface{}{})
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error listing tags for Cognito User Pool (%s): %w", userPoolIDs[i], err)
+			}
+
+			poolTags = poolTags.IgnoreAWS().IgnoreConfig(client.IgnoreTagsConfig)
+
+			tags = append(tags, poolTags.RemoveDefaultConfig(client.DefaultTagsConfig).Map())
+			tagsAll = append(tagsAll, poolTags.Map())
+		}
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tagsAll); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
 	}
 
 	d.SetId(name)
 	d.Set("ids", userPoolIDs)
 	d.Set("arns", arns)
+	d.Set("creation_dates", creationDates)
 
 	return nil
 }
 
+// userPoolsDataSourcePool is a user pool matched by the data source.
+type userPoolsDataSourcePool struct {
+	arn          string
+	creationDate time.Time
+	described    *cognitoidentityprovider.UserPoolType
+	id           string
+}
+
+// userPoolCreationDate returns the creation date of a listed user pool,
+// describing the pool when the listing does not include it. The described pool
+// is returned as well, or nil when the pool was not described.
+func userPoolCreationDate(conn *cognitoidentityprovider.CognitoIdentityProvider, v *cognitoidentityprovider.UserPoolDescriptionType) (time.Time, *cognitoidentityprovider.UserPoolType, error) {
+	if v.CreationDate != nil {
+		return aws.TimeValue(v.CreationDate), nil, nil
+	}
+
+	userPool, err := describeUserPool(conn, aws.StringValue(v.Id))
+
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	if userPool.CreationDate == nil {
+		return time.Time{}, nil, fmt.Errorf("empty creation date")
+	}
+
+	return aws.TimeValue(userPool.CreationDate), userPool, nil
+}
+
+// describeUserPool describes a user pool, retrying throttled requests.
+func describeUserPool(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID string) (*cognitoidentityprovider.UserPoolType, error) {
+	input := &cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(describeUserPoolThrottleTimeout, func() (interface{}, error) {
+		return conn.DescribeUserPool(input)
+	}, cognitoidentityprovider.ErrCodeTooManyRequestsException)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := outputRaw.(*cognitoidentityprovider.DescribeUserPoolOutput)
+
+	if output == nil || output.UserPool == nil {
+		return nil, fmt.Errorf("empty result")
+	}
+
+	return output.UserPool, nil
+}
+
+// userPoolDomain returns the hosted UI domain of a user pool, preferring its
+// custom domain over its Amazon Cognito domain prefix, or an empty string when
+// the pool has neither.
+func userPoolDomain(userPool *cognitoidentityprovider.UserPoolType) string {
+	if v := aws.StringValue(userPool.CustomDomain); v != "" {
+		return v
+	}
+
+	return aws.StringValue(userPool.Domain)
+}
+
+// userPoolMfaConfiguration returns the MFA configuration (OFF, ON or OPTIONAL)
+// of a user pool, retrying throttled requests.
+func userPoolMfaConfiguration(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID string) (string, error) {
+	input := &cognitoidentityprovider.GetUserPoolMfaConfigInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(getUserPoolMfaConfigThrottleTimeout, func() (interface{}, error) {
+		return conn.GetUserPoolMfaConfig(input)
+	}, cognitoidentityprovider.ErrCodeTooManyRequestsException)
+
+	if err != nil {
+		return "", err
+	}
+
+	output := outputRaw.(*cognitoidentityprovider.GetUserPoolMfaConfigOutput)
+
+	// MFA is off for pools where it was never configured.
+	if output == nil || output.MfaConfiguration == nil {
+		return cognitoidentityprovider.UserPoolMfaTypeOff, nil
+	}
+
+	return aws.StringValue(output.MfaConfiguration), nil
+}
+
+// userPoolTags returns the tags of a user pool, retrying throttled requests.
+func userPoolTags(conn *cognitoidentityprovider.CognitoIdentityProvider, arn string) (tftags.KeyValueTags, error) {
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(listTagsForResourceThrottleTimeout, func() (interface{}, error) {
+		return ListTags(conn, arn)
+	}, cognitoidentityprovider.ErrCodeTooManyRequestsException)
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return outputRaw.(tftags.KeyValueTags), nil
+}
+
+// countUserPoolClients returns the number of app clients in a user pool.
+// Throttled listings are restarted from the first page.
+func countUserPoolClients(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID string) (int, error) {
+	input := &cognitoidentityprovider.ListUserPoolClientsInput{
+		MaxResults: aws.Int64(60),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(listUserPoolClientsThrottleTimeout, func() (interface{}, error) {
+		var count, pages int
+		var exceeded bool
+
+		err := conn.ListUserPoolClientsPages(input, func(page *cognitoidentityprovider.ListUserPoolClientsOutput, lastPage bool) bool {
+			if pages++; !lastPage && pages >= listUserPoolsMaxPages {
+				exceeded = true
+				return false
+			}
+
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.UserPoolClients {
+				if v != nil {
+					count++
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err == nil && exceeded {
+			err = fmt.Errorf("listing Cognito User Pool (%s) clients exceeded %d pages", userPoolID, listUserPoolsMaxPages)
+		}
+
+		return count, err
+	}, cognitoidentityprovider.ErrCodeTooManyRequestsException)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return outputRaw.(int), nil
+}
+
 func findUserPoolDescriptionTypes(conn *cognitoidentityprovider.CognitoIdentityProvider) ([]*cognitoidentityprovider.UserPoolDescriptionType, error) {
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(60),
 	}
 	var output []*cognitoidentityprovider.UserPoolDescriptionType
+	var pages int
+	var exceeded bool
 
 	err := conn.ListUserPoolsPages(input, func(page *cognitoidentityprovider.ListUserPoolsOutput, lastPage bool) bool {
+		if pages++; !lastPage && pages >= listUserPoolsMaxPages {
+			exceeded = true
+			return false
+		}
+
 		if page == nil {
 			return !lastPage
 		}
@@ -94,5 +527,9 @@ func findUserPoolDescriptionTypes(conn *cognitoidentityprovider.CognitoIdentityP
 		return nil, err
 	}
 
+	if exceeded {
+		return nil, fmt.Errorf("listing Cognito User Pools exceeded %d pages", listUserPoolsMaxPages)
+	}
+
 	return output, nil
 }
@@ -2,14 +2,622 @@ package cognitoidp_test
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcognitoidp "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+func TestUserPoolsDataSourceClientCounts(t *testing.T) {
+	var throttled bool
+	conn := newMockCognitoIDPConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *cognitoidentityprovider.ListUserPoolsOutput:
+			data.UserPools = []*cognitoidentityprovider.UserPoolDescriptionType{
+				{Id: aws.String("us-west-2_aaaaaaaaa"), Name: aws.String("test")},
+				{Id: aws.String("us-west-2_bbbbbbbbb"), Name: aws.String("other")},
+				{Id: aws.String("us-west-2_ccccccccc"), Name: aws.String("test")},
+			}
+		case *cognitoidentityprovider.ListUserPoolClientsOutput:
+			input := r.Params.(*cognitoidentityprovider.ListUserPoolClientsInput)
+
+			if aws.StringValue(input.UserPoolId) != "us-west-2_aaaaaaaaa" {
+				return
+			}
+
+			if aws.StringValue(input.NextToken) == "" {
+				data.UserPoolClients = []*cognitoidentityprovider.UserPoolClientDescription{
+					{ClientId: aws.String("client1")},
+					{ClientId: aws.String("client2")},
+				}
+				data.NextToken = aws.String("page2")
+				return
+			}
+
+			// Throttle the second page once.
+			if !throttled {
+				throttled = true
+				r.Error = awserr.New(cognitoidentityprovider.ErrCodeTooManyRequestsException, "Rate exceeded", nil)
+				return
+			}
+
+			data.UserPoolClients = []*cognitoidentityprovider.UserPoolClientDescription{
+				{ClientId: aws.String("client3")},
+			}
+		default:
+			if !testUserPoolsDataSourcePoolDetails(r) {
+				t.Errorf("unexpected operation: %s", r.Operation.Name)
+			}
+		}
+	})
+
+	r := tfcognitoidp.DataSourceUserPools()
+	d := r.TestResourceData()
+	d.Set("name", "test")
+	d.Set("include_client_counts", true)
+
+	if err := r.Read(d, &conns.AWSClient{AccountID: "123456789012", CognitoIDPConn: conn, Partition: "aws", Region: "us-west-2"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !throttled {
+		t.Error("expected the client listing to be throttled")
+	}
+
+	if got, want := d.Get("ids").([]interface{}), []interface{}{"us-west-2_aaaaaaaaa", "us-west-2_ccccccccc"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got ids %v, expected %v", got, want)
+	}
+
+	if got, want := d.Get("client_counts").([]interface{}), []interface{}{3, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got client_counts %v, expected %v", got, want)
+	}
+}
+
+func TestUserPoolsDataSourceAccessDenied(t *testing.T) {
+	conn := newMockCognitoIDPConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *cognitoidentityprovider.ListUserPoolsOutput:
+			data.UserPools = []*cognitoidentityprovider.UserPoolDescriptionType{
+				{Id: aws.String("us-west-2_aaaaaaaaa"), Name: aws.String("test")},
+				{Id: aws.String("us-west-2_bbbbbbbbb"), Name: aws.String("test")},
+			}
+		case *cognitoidentityprovider.ListUserPoolClientsOutput:
+			r.Error = awserr.New("AccessDeniedException", "User is not authorized to perform: cognito-idp:ListUserPoolClients", nil)
+		case *cognitoidentityprovider.DescribeUserPoolOutput:
+			r.Error = awserr.New("AccessDeniedException", "User is not authorized to perform: cognito-idp:DescribeUserPool", nil)
+		case *cognitoidentityprovider.GetUserPoolMfaConfigOutput:
+			r.Error = awserr.New("AccessDeniedException", "User is not authorized to perform: cognito-idp:GetUserPoolMfaConfig", nil)
+		case *cognitoidentityprovider.ListTagsForResourceOutput:
+			r.Error = awserr.New("AccessDeniedException", "User is not authorized to perform: cognito-idp:ListTagsForResource", nil)
+		default:
+			if !testUserPoolsDataSourcePoolDetails(r) {
+				t.Errorf("unexpected operation: %s", r.Operation.Name)
+			}
+		}
+	})
+
+	r := tfcognitoidp.DataSourceUserPools()
+	d := r.TestResourceData()
+	d.Set("name", "test")
+	d.Set("include_client_counts", true)
+	d.Set("include_domains", true)
+	d.Set("include_mfa_configurations", true)
+	d.Set("include_tags", true)
+
+	if err := r.Read(d, &conns.AWSClient{AccountID: "123456789012", CognitoIDPConn: conn, Partition: "aws", Region: "us-west-2"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := d.Get("ids").([]interface{}), []interface{}{"us-west-2_aaaaaaaaa", "us-west-2_bbbbbbbbb"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got ids %v, expected %v", got, want)
+	}
+
+	expectedARNs := []interface{}{
+		"arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_aaaaaaaaa", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_bbbbbbbbb", //lintignore:AWSAT003,AWSAT005
+	}
+
+	if got := d.Get("arns").([]interface{}); !reflect.DeepEqual(got, expectedARNs) {
+		t.Errorf("got arns %v, expected %v", got, expectedARNs)
+	}
+
+	if got, want := d.Get("client_counts").([]interface{}), []interface{}{-1, -1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got client_counts %v, expected %v", got, want)
+	}
+
+	if got, want := d.Get("domains").([]interface{}), []interface{}{"", ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got domains %v, expected %v", got, want)
+	}
+
+	if got, want := d.Get("mfa_configurations").([]interface{}), []interface{}{"", ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got mfa_configurations %v, expected %v", got, want)
+	}
+
+	expectedTags := []interface{}{map[string]interface{}{}, map[string]interface{}{}}
+
+	if got := d.Get("tags").([]interface{}); !reflect.DeepEqual(got, expectedTags) {
+		t.Errorf("got tags %v, expected %v", got, expectedTags)
+	}
+
+	if got := d.Get("tags_all").([]interface{}); !reflect.DeepEqual(got, expectedTags) {
+		t.Errorf("got tags_all %v, expected %v", got, expectedTags)
+	}
+}
+
+func TestUserPoolsDataSourceMfaConfigurations(t *testing.T) {
+	var throttled bool
+	conn := newMockCognitoIDPConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *cognitoidentityprovider.ListUserPoolsOutput:
+			data.UserPools = []*cognitoidentityprovider.UserPoolDescriptionType{
+				{Id: aws.String("us-west-2_aaaaaaaaa"), Name: aws.String("test")},
+				{Id: aws.String("us-west-2_bbbbbbbbb"), Name: aws.String("other")},
+				{Id: aws.String("us-west-2_ccccccccc"), Name: aws.String("test")},
+				{Id: aws.String("us-west-2_ddddddddd"), Name: aws.String("test")},
+			}
+		case *cognitoidentityprovider.GetUserPoolMfaConfigOutput:
+			switch aws.StringValue(r.Params.(*cognitoidentityprovider.GetUserPoolMfaConfigInput).UserPoolId) {
+			case "us-west-2_aaaaaaaaa":
+				data.MfaConfiguration = aws.String(cognitoidentityprovider.UserPoolMfaTypeOn)
+			case "us-west-2_ccccccccc":
+				// Throttle once.
+				if !throttled {
+					throttled = true
+					r.Error = awserr.New(cognitoidentityprovider.ErrCodeTooManyRequestsException, "Rate exceeded", nil)
+					return
+				}
+
+				data.MfaConfiguration = aws.String(cognitoidentityprovider.UserPoolMfaTypeOptional)
+			}
+		default:
+			if !testUserPoolsDataSourcePoolDetails(r) {
+				t.Errorf("unexpected operation: %s", r.Operation.Name)
+			}
+		}
+	})
+
+	r := tfcognitoidp.DataSourceUserPools()
+	d := r.TestResourceData()
+	d.Set("name", "test")
+	d.Set("include_mfa_configurations", true)
+
+	if err := r.Read(d, &conns.AWSClient{AccountID: "123456789012", CognitoIDPConn: conn, Partition: "aws", Region: "us-west-2"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !throttled {
+		t.Error("expected the MFA configuration read to be throttled")
+	}
+
+	if got, want := d.Get("ids").([]interface{}), []interface{}{"us-west-2_aaaaaaaaa", "us-west-2_ccccccccc", "us-west-2_ddddddddd"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got ids %v, expected %v", got, want)
+	}
+
+	if got, want := d.Get("mfa_configurations").([]interface{}), []interface{}{"ON", "OPTIONAL", "OFF"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got mfa_configurations %v, expected %v", got, want)
+	}
+}
+
+func TestUserPoolsDataSourceDomains(t *testing.T) {
+	var throttled bool
+	conn := newMockCognitoIDPConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *cognitoidentityprovider.ListUserPoolsOutput:
+			data.UserPools = []*cognitoidentityprovider.UserPoolDescriptionType{
+				{Id: aws.String("us-west-2_aaaaaaaaa"), Name: aws.String("test")},
+				{Id: aws.String("us-west-2_bbbbbbbbb"), Name: aws.String("other")},
+				{Id: aws.String("us-west-2_ccccccccc"), Name: aws.String("test")},
+				{Id: aws.String("us-west-2_ddddddddd"), Name: aws.String("test")},
+			}
+		case *cognitoidentityprovider.DescribeUserPoolOutput:
+			data.UserPool = &cognitoidentityprovider.UserPoolType{}
+
+			switch aws.StringValue(r.Params.(*cognitoidentityprovider.DescribeUserPoolInput).UserPoolId) {
+			case "us-west-2_aaaaaaaaa":
+				data.UserPool.Domain = aws.String("test-prefix")
+			case "us-west-2_ccccccccc":
+				// Throttle once.
+				if !throttled {
+					throttled = true
+					r.Error = awserr.New(cognitoidentityprovider.ErrCodeTooManyRequestsException, "Rate exceeded", nil)
+					return
+				}
+
+				data.UserPool.CustomDomain = aws.String("auth.example.com")
+			}
+		default:
+			if !testUserPoolsDataSourcePoolDetails(r) {
+				t.Errorf("unexpected operation: %s", r.Operation.Name)
+			}
+		}
+	})
+
+	r := tfcognitoidp.DataSourceUserPools()
+	d := r.TestResourceData()
+	d.Set("name", "test")
+	d.Set("include_domains", true)
+
+	if err := r.Read(d, &conns.AWSClient{AccountID: "123456789012", CognitoIDPConn: conn, Partition: "aws", Region: "us-west-2"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !throttled {
+		t.Error("expected the user pool description to be throttled")
+	}
+
+	if got, want := d.Get("ids").([]interface{}), []interface{}{"us-west-2_aaaaaaaaa", "us-west-2_ccccccccc", "us-west-2_ddddddddd"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got ids %v, expected %v", got, want)
+	}
+
+	if got, want := d.Get("domains").([]interface{}), []interface{}{"test-prefix", "auth.example.com", ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got domains %v, expected %v", got, want)
+	}
+}
+
+func TestUserPoolsDataSourceMaxPages(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		EndlessPools  bool
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:          "user pools",
+			EndlessPools:  true,
+			ExpectedError: regexp.MustCompile(`listing Cognito User Pools exceeded \d+ pages`),
+		},
+		{
+			Name:          "user pool clients",
+			ExpectedError: regexp.MustCompile(`listing Cognito User Pool \(us-west-2_aaaaaaaaa\) clients exceeded \d+ pages`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// Pagers that never end.
+			var pages int
+			conn := newMockCognitoIDPConn(t, func(r *request.Request) {
+				pages++
+
+				switch data := r.Data.(type) {
+				case *cognitoidentityprovider.ListUserPoolsOutput:
+					data.UserPools = []*cognitoidentityprovider.UserPoolDescriptionType{
+						{Id: aws.String("us-west-2_aaaaaaaaa"), Name: aws.String("test")},
+					}
+
+					if testCase.EndlessPools {
+						data.NextToken = aws.String(fmt.Sprintf("page%d", pages+1))
+					}
+				case *cognitoidentityprovider.ListUserPoolClientsOutput:
+					data.NextToken = aws.String(fmt.Sprintf("page%d", pages+1))
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+
+			r := tfcognitoidp.DataSourceUserPools()
+			d := r.TestResourceData()
+			d.Set("name", "test")
+			d.Set("include_client_counts", true)
+
+			err := r.Read(d, &conns.AWSClient{AccountID: "123456789012", CognitoIDPConn: conn, Partition: "aws", Region: "us-west-2"})
+
+			if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestUserPoolsDataSourceCreationDate(t *testing.T) {
+	var described []string
+	conn := newMockCognitoIDPConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *cognitoidentityprovider.ListUserPoolsOutput:
+			data.UserPools = []*cognitoidentityprovider.UserPoolDescriptionType{
+				{Id: aws.String("us-west-2_aaaaaaaaa"), Name: aws.String("test"), CreationDate: aws.Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))},
+				{Id: aws.String("us-west-2_bbbbbbbbb"), Name: aws.String("test"), CreationDate: aws.Time(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))},
+				{Id: aws.String("us-west-2_ccccccccc"), Name: aws.String("other"), CreationDate: aws.Time(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))},
+				// Listed without a creation date, so it has to be described.
+				{Id: aws.String("us-west-2_ddddddddd"), Name: aws.String("test")},
+			}
+		case *cognitoidentityprovider.DescribeUserPoolOutput:
+			userPoolID := aws.StringValue(r.Params.(*cognitoidentityprovider.DescribeUserPoolInput).UserPoolId)
+			described = append(described, userPoolID)
+
+			data.UserPool = &cognitoidentityprovider.UserPoolType{
+				Id:           aws.String(userPoolID),
+				CreationDate: aws.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			}
+		default:
+			if !testUserPoolsDataSourcePoolDetails(r) {
+				t.Errorf("unexpected operation: %s", r.Operation.Name)
+			}
+		}
+	})
+
+	// Pools without a listed creation date are described while filtering,
+	// every matching pool is described for its domain, and no pool is described twice.
+	testCases := []struct {
+		Name              string
+		CreatedAfter      string
+		CreatedBefore     string
+		ExpectedIDs       []interface{}
+		ExpectedDescribed []string
+	}{
+		{
+			Name:              "no filter",
+			ExpectedIDs:       []interface{}{"us-west-2_aaaaaaaaa", "us-west-2_bbbbbbbbb", "us-west-2_ddddddddd"},
+			ExpectedDescribed: []string{"us-west-2_aaaaaaaaa", "us-west-2_bbbbbbbbb", "us-west-2_ddddddddd"},
+		},
+		{
+			Name:              "created after",
+			CreatedAfter:      "2020-06-01T00:00:00Z",
+			ExpectedIDs:       []interface{}{"us-west-2_bbbbbbbbb", "us-west-2_ddddddddd"},
+			ExpectedDescribed: []string{"us-west-2_ddddddddd", "us-west-2_bbbbbbbbb"},
+		},
+		{
+			Name:              "created before",
+			CreatedBefore:     "2021-01-01T00:00:00Z",
+			ExpectedIDs:       []interface{}{"us-west-2_aaaaaaaaa"},
+			ExpectedDescribed: []string{"us-west-2_ddddddddd", "us-west-2_aaaaaaaaa"},
+		},
+		{
+			Name:              "created between",
+			CreatedAfter:      "2020-06-01T00:00:00Z",
+			CreatedBefore:     "2021-06-01T00:00:00Z",
+			ExpectedIDs:       []interface{}{"us-west-2_bbbbbbbbb"},
+			ExpectedDescribed: []string{"us-west-2_ddddddddd", "us-west-2_bbbbbbbbb"},
+		},
+		{
+			Name:              "no match",
+			CreatedAfter:      "2023-01-01T00:00:00Z",
+			ExpectedIDs:       []interface{}{},
+			ExpectedDescribed: []string{"us-west-2_ddddddddd"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			described = nil
+
+			r := tfcognitoidp.DataSourceUserPools()
+			d := r.TestResourceData()
+			d.Set("name", "test")
+			d.Set("include_domains", true)
+			d.Set("created_after", testCase.CreatedAfter)
+			d.Set("created_before", testCase.CreatedBefore)
+
+			err := r.Read(d, &conns.AWSClient{AccountID: "123456789012", CognitoIDPConn: conn, Partition: "aws", Region: "us-west-2"})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := d.Get("ids").([]interface{}), testCase.ExpectedIDs; !reflect.DeepEqual(got, want) {
+				t.Errorf("got ids %v, expected %v", got, want)
+			}
+
+			if got, want := described, testCase.ExpectedDescribed; !reflect.DeepEqual(got, want) {
+				t.Errorf("got described pools %v, expected %v", got, want)
+			}
+		})
+	}
+}
+
+func TestUserPoolsDataSourceMostRecent(t *testing.T) {
+	conn := newMockCognitoIDPConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *cognitoidentityprovider.ListUserPoolsOutput:
+			data.UserPools = []*cognitoidentityprovider.UserPoolDescriptionType{
+				{Id: aws.String("us-west-2_ccccccccc"), Name: aws.String("test"), CreationDate: aws.Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))},
+				{Id: aws.String("us-west-2_bbbbbbbbb"), Name: aws.String("test"), CreationDate: aws.Time(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))},
+				{Id: aws.String("us-west-2_eeeeeeeee"), Name: aws.String("other"), CreationDate: aws.Time(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))},
+				// Created at the same time as us-west-2_bbbbbbbbb.
+				{Id: aws.String("us-west-2_aaaaaaaaa"), Name: aws.String("test"), CreationDate: aws.Time(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))},
+				// Listed without a creation date, so it has to be described.
+				{Id: aws.String("us-west-2_ddddddddd"), Name: aws.String("test")},
+			}
+		case *cognitoidentityprovider.DescribeUserPoolOutput:
+			userPoolID := aws.StringValue(r.Params.(*cognitoidentityprovider.DescribeUserPoolInput).UserPoolId)
+
+			data.UserPool = &cognitoidentityprovider.UserPoolType{
+				Id:           aws.String(userPoolID),
+				CreationDate: aws.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			}
+		default:
+			if !testUserPoolsDataSourcePoolDetails(r) {
+				t.Errorf("unexpected operation: %s", r.Operation.Name)
+			}
+		}
+	})
+
+	testCases := []struct {
+		Name                  string
+		MostRecent            bool
+		ExpectedIDs           []interface{}
+		ExpectedCreationDates []interface{}
+	}{
+		{
+			Name:                  "listing order",
+			ExpectedIDs:           []interface{}{"us-west-2_ccccccccc", "us-west-2_bbbbbbbbb", "us-west-2_aaaaaaaaa", "us-west-2_ddddddddd"},
+			ExpectedCreationDates: []interface{}{},
+		},
+		{
+			Name:                  "most recent",
+			MostRecent:            true,
+			ExpectedIDs:           []interface{}{"us-west-2_ddddddddd", "us-west-2_aaaaaaaaa", "us-west-2_bbbbbbbbb", "us-west-2_ccccccccc"},
+			ExpectedCreationDates: []interface{}{"2022-01-01T00:00:00Z", "2021-01-01T00:00:00Z", "2021-01-01T00:00:00Z", "2020-01-01T00:00:00Z"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := tfcognitoidp.DataSourceUserPools()
+			d := r.TestResourceData()
+			d.Set("name", "test")
+			d.Set("most_recent", testCase.MostRecent)
+
+			if err := r.Read(d, &conns.AWSClient{AccountID: "123456789012", CognitoIDPConn: conn, Partition: "aws", Region: "us-west-2"}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := d.Get("ids").([]interface{}), testCase.ExpectedIDs; !reflect.DeepEqual(got, want) {
+				t.Errorf("got ids %v, expected %v", got, want)
+			}
+
+			var wantARNs []interface{}
+			for _, id := range testCase.ExpectedIDs {
+				wantARNs = append(wantARNs, fmt.Sprintf("arn:aws:cognito-idp:us-west-2:123456789012:userpool/%s", id)) //lintignore:AWSAT003,AWSAT005
+			}
+
+			if got := d.Get("arns").([]interface{}); !reflect.DeepEqual(got, wantARNs) {
+				t.Errorf("got arns %v, expected %v", got, wantARNs)
+			}
+
+			if got, want := d.Get("creation_dates").([]interface{}), testCase.ExpectedCreationDates; !reflect.DeepEqual(got, want) {
+				t.Errorf("got creation_dates %v, expected %v", got, want)
+			}
+		})
+	}
+}
+
+func TestUserPoolsDataSourceTags(t *testing.T) {
+	var arns []string
+	var throttled bool
+	conn := newMockCognitoIDPConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *cognitoidentityprovider.ListUserPoolsOutput:
+			data.UserPools = []*cognitoidentityprovider.UserPoolDescriptionType{
+				{Id: aws.String("us-west-2_aaaaaaaaa"), Name: aws.String("test")},
+				{Id: aws.String("us-west-2_bbbbbbbbb"), Name: aws.String("test")},
+			}
+		case *cognitoidentityprovider.ListTagsForResourceOutput:
+			input := r.Params.(*cognitoidentityprovider.ListTagsForResourceInput)
+			arns = append(arns, aws.StringValue(input.ResourceArn))
+
+			if strings.HasSuffix(aws.StringValue(input.ResourceArn), "us-west-2_aaaaaaaaa") {
+				data.Tags = aws.StringMap(map[string]string{
+					"aws:cloudformation:stack-name": "stack",
+					"defaultkey":                    "defaultvalue",
+					"ignorekey1":                    "ignorevalue1",
+					"key1":                          "value1",
+				})
+			}
+
+			// Throttle once.
+			if strings.HasSuffix(aws.StringValue(input.ResourceArn), "us-west-2_bbbbbbbbb") && !throttled {
+				throttled = true
+				r.Error = awserr.New(cognitoidentityprovider.ErrCodeTooManyRequestsException, "Rate exceeded", nil)
+				return
+			}
+		default:
+			if !testUserPoolsDataSourcePoolDetails(r) {
+				t.Errorf("unexpected operation: %s", r.Operation.Name)
+			}
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID:      "123456789012",
+		CognitoIDPConn: conn,
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags: tftags.New(map[string]interface{}{"defaultkey": "defaultvalue"}),
+		},
+		IgnoreTagsConfig: &tftags.IgnoreConfig{
+			KeyPrefixes: tftags.New([]interface{}{"ignorekey"}),
+		},
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	r := tfcognitoidp.DataSourceUserPools()
+	d := r.TestResourceData()
+	d.Set("name", "test")
+	d.Set("include_tags", true)
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedARNs := []string{
+		"arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_aaaaaaaaa", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_bbbbbbbbb", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_bbbbbbbbb", //lintignore:AWSAT003,AWSAT005
+	}
+
+	if !reflect.DeepEqual(arns, expectedARNs) {
+		t.Errorf("got tags listed for %v, expected %v", arns, expectedARNs)
+	}
+
+	expectedTags := []interface{}{
+		map[string]interface{}{"key1": "value1"},
+		map[string]interface{}{},
+	}
+
+	if got := d.Get("tags").([]interface{}); !reflect.DeepEqual(got, expectedTags) {
+		t.Errorf("got tags %v, expected %v", got, expectedTags)
+	}
+
+	expectedTagsAll := []interface{}{
+		map[string]interface{}{"defaultkey": "defaultvalue", "key1": "value1"},
+		map[string]interface{}{},
+	}
+
+	if got := d.Get("tags_all").([]interface{}); !reflect.DeepEqual(got, expectedTagsAll) {
+		t.Errorf("got tags_all %v, expected %v", got, expectedTagsAll)
+	}
+}
+
+// newMockCognitoIDPConn returns a Cognito IDP client that hands every request to
+// send instead of calling AWS. send fills in the request's output or sets its error.
+func newMockCognitoIDPConn(t *testing.T, send func(r *request.Request)) *cognitoidentityprovider.CognitoIdentityProvider {
+	t.Helper()
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := cognitoidentityprovider.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(send)
+
+	return conn
+}
+
+// testUserPoolsDataSourcePoolDetails fills in an empty result for the per-pool
+// reads that a test does not cover, returning whether it handled the request.
+func testUserPoolsDataSourcePoolDetails(r *request.Request) bool {
+	switch data := r.Data.(type) {
+	case *cognitoidentityprovider.DescribeUserPoolOutput:
+		data.UserPool = &cognitoidentityprovider.UserPoolType{
+			Id: r.Params.(*cognitoidentityprovider.DescribeUserPoolInput).UserPoolId,
+		}
+
+		return true
+	case *cognitoidentityprovider.GetUserPoolMfaConfigOutput,
+		*cognitoidentityprovider.ListUserPoolClientsOutput,
+		*cognitoidentityprovider.ListTagsForResourceOutput:
+		return true
+	}
+
+	return false
+}
+
 func TestAccCognitoIDPUserPoolsDataSource_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -31,6 +639,176 @@ func TestAccCognitoIDPUserPoolsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIDPUserPoolsDataSource_clientCounts(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cognito_user_pools.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck: acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolsDataSourceClientCountsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "client_counts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "client_counts.0", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserPoolsDataSource_creationDate(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cognito_user_pools.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck: acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolsDataSourceCreationDateConfig(rName, "-1h", "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "aws_cognito_user_pool.test", "id"),
+				),
+			},
+			{
+				Config: testAccUserPoolsDataSourceCreationDateConfig(rName, "1h", "2h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserPoolsDataSource_mostRecent(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cognito_user_pools.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck: acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolsDataSourceMostRecentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "creation_dates.#", "2"),
+					testAccCheckUserPoolsDataSourceMostRecentFirst(dataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserPoolsDataSource_domains(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cognito_user_pools.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck: acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolsDataSourceDomainsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "domains.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domains.0", "aws_cognito_user_pool_domain.test", "domain"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserPoolsDataSource_mfaConfigurations(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cognito_user_pools.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck: acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolsDataSourceMfaConfigurationsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "mfa_configurations.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "mfa_configurations.0", "OPTIONAL"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserPoolsDataSource_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cognito_user_pools.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck: acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1("defaultkey1", "defaultvalue1", "ignorekey"),
+					testAccUserPoolsDataSourceTagsConfig(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.0.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.0.key1", "value1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.0.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.0.defaultkey1", "defaultvalue1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.0.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckUserPoolsDataSourceMostRecentFirst checks that the matching
+// pools are ordered newest first, with their creation dates alongside.
+func testAccCheckUserPoolsDataSourceMostRecentFirst(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		var previous time.Time
+
+		for i := 0; i < 2; i++ {
+			v := rs.Primary.Attributes[fmt.Sprintf("creation_dates.%d", i)]
+			creationDate, err := time.Parse(time.RFC3339, v)
+
+			if err != nil {
+				return fmt.Errorf("error parsing creation_dates.%d (%s): %w", i, v, err)
+			}
+
+			if i > 0 && creationDate.After(previous) {
+				return fmt.Errorf("expected user pools newest first, got creation dates %s and %s", previous.Format(time.RFC3339), v)
+			}
+
+			previous = creationDate
+		}
+
+		return nil
+	}
+}
+
 func testAccUserPoolsDataSourceConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "test" {
@@ -51,3 +829,115 @@ data "aws_cognito_user_pools" "empty" {
 }
 `, rName)
 }
+
+func testAccUserPoolsDataSourceClientCountsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  count        = 2
+  name         = "%[1]s-${count.index}"
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+data "aws_cognito_user_pools" "test" {
+  name                  = %[1]q
+  include_client_counts = true
+
+  depends_on = [aws_cognito_user_pool_client.test[0], aws_cognito_user_pool_client.test[1]]
+}
+`, rName)
+}
+
+func testAccUserPoolsDataSourceCreationDateConfig(rName, after, before string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+data "aws_cognito_user_pools" "test" {
+  name           = aws_cognito_user_pool.test.name
+  created_after  = timeadd(aws_cognito_user_pool.test.creation_date, %[2]q)
+  created_before = timeadd(aws_cognito_user_pool.test.creation_date, %[3]q)
+}
+`, rName, after, before)
+}
+
+func testAccUserPoolsDataSourceMostRecentConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "first" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool" "second" {
+  name = %[1]q
+
+  depends_on = [aws_cognito_user_pool.first]
+}
+
+data "aws_cognito_user_pools" "test" {
+  name        = %[1]q
+  most_recent = true
+
+  depends_on = [aws_cognito_user_pool.first, aws_cognito_user_pool.second]
+}
+`, rName)
+}
+
+func testAccUserPoolsDataSourceDomainsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+data "aws_cognito_user_pools" "test" {
+  name            = aws_cognito_user_pool.test.name
+  include_domains = true
+
+  depends_on = [aws_cognito_user_pool_domain.test]
+}
+`, rName)
+}
+
+func testAccUserPoolsDataSourceMfaConfigurationsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name              = %[1]q
+  mfa_configuration = "OPTIONAL"
+
+  software_token_mfa_configuration {
+    enabled = true
+  }
+}
+
+data "aws_cognito_user_pools" "test" {
+  name                       = aws_cognito_user_pool.test.name
+  include_mfa_configurations = true
+}
+`, rName)
+}
+
+func testAccUserPoolsDataSourceTagsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  tags = {
+    ignorekey1 = "ignorevalue1"
+    key1       = "value1"
+  }
+}
+
+data "aws_cognito_user_pools" "test" {
+  name         = aws_cognito_user_pool.test.name
+  include_tags = true
+}
+`, rName)
+}
@@ -14,6 +14,7 @@ const (
 	ErrCodeClientInvalidHostIDNotFound                    = "Client.InvalidHostID.NotFound"
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone   = "DefaultSubnetAlreadyExistsInAvailabilityZone"
 	ErrCodeDependencyViolation                            = "DependencyViolation"
+	ErrCodeDryRunOperation                                = "DryRunOperation"
 	ErrCodeGatewayNotAttached                             = "Gateway.NotAttached"
 	ErrCodeIncorrectState                                 = "IncorrectState"
 	ErrCodeInvalidAMIIDNotFound                           = "InvalidAMIID.NotFound"
@@ -83,6 +84,7 @@ const (
 	ErrCodeInvalidVpnGatewayAttachmentNotFound            = "InvalidVpnGatewayAttachment.NotFound"
 	ErrCodeInvalidVpnGatewayIDNotFound                    = "InvalidVpnGatewayID.NotFound"
 	ErrCodeNatGatewayNotFound                             = "NatGatewayNotFound"
+	ErrCodeTrafficMirrorFilterRuleAlreadyExists           = "TrafficMirrorFilterRuleAlreadyExists"
 	ErrCodeUnsupportedOperation                           = "UnsupportedOperation"
 )
 
@@ -8,7 +8,10 @@ import (
 )
 
 // ec2TagSpecificationsFromKeyValueTags returns the tag specifications for the given KeyValueTags object and resource type.
+// The tags should already include any provider default tags; no specification is returned if none remain after removing AWS reserved tags.
 func ec2TagSpecificationsFromKeyValueTags(tags tftags.KeyValueTags, t string) []*ec2.TagSpecification {
+	tags = tags.IgnoreAWS()
+
 	if len(tags) == 0 {
 		return nil
 	}
@@ -16,7 +19,7 @@ func ec2TagSpecificationsFromKeyValueTags(tags tftags.KeyValueTags, t string) []
 	return []*ec2.TagSpecification{
 		{
 			ResourceType: aws.String(t),
-			Tags:         Tags(tags.IgnoreAWS()),
+			Tags:         Tags(tags),
 		},
 	}
 }
@@ -0,0 +1,79 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestEC2TagSpecificationsFromKeyValueTags(t *testing.T) {
+	defaultTagsConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(map[string]interface{}{
+			"providerkey1": "providervalue1",
+		}),
+	}
+
+	testCases := []struct {
+		Name     string
+		Tags     tftags.KeyValueTags
+		Expected map[string]string
+	}{
+		{
+			Name: "no tags",
+			Tags: tftags.New(map[string]interface{}{}),
+		},
+		{
+			Name: "AWS reserved tags only",
+			Tags: tftags.New(map[string]interface{}{
+				"aws:cloudformation:stack-name": "stack",
+			}),
+		},
+		{
+			Name: "default tags without resource tags",
+			Tags: defaultTagsConfig.MergeTags(tftags.New(map[string]interface{}{})),
+			Expected: map[string]string{
+				"providerkey1": "providervalue1",
+			},
+		},
+		{
+			Name: "default tags with resource tags",
+			Tags: defaultTagsConfig.MergeTags(tftags.New(map[string]interface{}{
+				"key1": "value1",
+			})),
+			Expected: map[string]string{
+				"key1":         "value1",
+				"providerkey1": "providervalue1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := ec2TagSpecificationsFromKeyValueTags(testCase.Tags, ec2.ResourceTypeTrafficMirrorFilter)
+
+			if len(testCase.Expected) == 0 {
+				if got != nil {
+					t.Fatalf("expected no tag specifications, got: %s", got)
+				}
+
+				return
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected 1 tag specification, got: %s", got)
+			}
+
+			if v := aws.StringValue(got[0].ResourceType); v != ec2.ResourceTypeTrafficMirrorFilter {
+				t.Errorf("got resource type %s, expected %s", v, ec2.ResourceTypeTrafficMirrorFilter)
+			}
+
+			if !KeyValueTags(got[0].Tags).Equal(tftags.New(testCase.Expected)) {
+				t.Errorf("got tags %s, expected %s", KeyValueTags(got[0].Tags).Map(), testCase.Expected)
+			}
+		})
+	}
+}
@@ -1,13 +1,16 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -16,6 +19,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// trafficMirrorFilterDryRunIDPrefix prefixes the synthetic ID of a dry-run filter.
+const trafficMirrorFilterDryRunIDPrefix = "tmf-dryrun-"
+
 func ResourceTrafficMirrorFilter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTrafficMirrorFilterCreate,
@@ -26,46 +32,156 @@ func ResourceTrafficMirrorFilter() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			resourceTrafficMirrorFilterNetworkServicesDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"client_token": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"count_sessions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ignore_network_services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
 			"network_services": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
-					ValidateFunc: validation.StringInSlice([]string{
-						"amazon-dns",
-					}, false),
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
+			"prevent_destroy_with_sessions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rule_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"session_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"strict_network_services": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 	}
 }
 
+// resourceTrafficMirrorFilterNetworkServicesDiff rejects services that are both managed and ignored,
+// and, unless strict_network_services is false, services unknown to the provider.
+// Unknown services are only checked here, as a ValidateFunc cannot read strict_network_services.
+func resourceTrafficMirrorFilterNetworkServicesDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	networkServices := diff.Get("network_services").(*schema.Set)
+	ignoreNetworkServices := diff.Get("ignore_network_services").(*schema.Set)
+
+	// Services released after this provider version are left to the EC2 API to validate when not strict.
+	if diff.Get("strict_network_services").(bool) {
+		validateNetworkService := validation.StringInSlice(ec2.TrafficMirrorNetworkService_Values(), false)
+
+		for _, k := range []string{"ignore_network_services", "network_services"} {
+			if !diff.NewValueKnown(k) {
+				continue
+			}
+
+			for _, v := range diff.Get(k).(*schema.Set).List() {
+				// ResourceDiff reads each element being removed from the set as an
+				// empty string. Empty services in the configuration are rejected by
+				// the element ValidateFunc already.
+				if v.(string) == "" {
+					continue
+				}
+
+				if _, errs := validateNetworkService(v, k); len(errs) > 0 {
+					return fmt.Errorf("%w, or set strict_network_services to false", errs[0])
+				}
+			}
+		}
+	}
+
+	if overlap := networkServices.Intersection(ignoreNetworkServices); overlap.Len() > 0 {
+		return fmt.Errorf("network services %q are both in network_services and ignore_network_services, remove them from one of the two", aws.StringValueSlice(flex.ExpandStringSet(overlap)))
+	}
+
+	return nil
+}
+
 func resourceTrafficMirrorFilterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	input := &ec2.CreateTrafficMirrorFilterInput{}
+	// The token is set once, so requests retried by the SDK do not create duplicate filters.
+	clientToken := resource.UniqueId()
+
+	if v, ok := d.GetOk("client_token"); ok {
+		clientToken = v.(string)
+	}
+
+	input := &ec2.CreateTrafficMirrorFilterInput{
+		ClientToken: aws.String(clientToken),
+		// Provider default tags are merged in above so that they are applied at creation even without resource tags.
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTrafficMirrorFilter),
+	}
 
 	if description, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(description.(string))
 	}
 
-	if len(tags) > 0 {
-		input.TagSpecifications = ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTrafficMirrorFilter)
+	// A dry-run filter only checks that the caller is authorized to create it.
+	// Nothing is created, so a synthetic ID is used.
+	if d.Get("dry_run").(bool) {
+		input.DryRun = aws.Bool(true)
+
+		_, err := conn.CreateTrafficMirrorFilter(input)
+
+		if !tfawserr.ErrCodeEquals(err, ErrCodeDryRunOperation) {
+			if err == nil {
+				err = fmt.Errorf("expected %s error", ErrCodeDryRunOperation)
+			}
+
+			return fmt.Errorf("error creating EC2 Traffic Mirror Filter (dry run): %w", err)
+		}
+
+		d.SetId(trafficMirrorFilterDryRunIDPrefix + resource.UniqueId())
+		d.Set("client_token", clientToken)
+
+		return resourceTrafficMirrorFilterRead(d, meta)
 	}
 
 	out, err := conn.CreateTrafficMirrorFilter(input)
@@ -74,6 +190,7 @@ func resourceTrafficMirrorFilterCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(aws.StringValue(out.TrafficMirrorFilter.TrafficMirrorFilterId))
+	d.Set("client_token", clientToken)
 
 	if v, ok := d.GetOk("network_services"); ok {
 		input := &ec2.ModifyTrafficMirrorFilterNetworkServicesInput{
@@ -94,25 +211,35 @@ func resourceTrafficMirrorFilterCreate(d *schema.ResourceData, meta interface{})
 func resourceTrafficMirrorFilterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
+	if d.Get("dry_run").(bool) {
+		return resourceTrafficMirrorFilterRead(d, meta)
+	}
+
 	if d.HasChange("network_services") {
 		input := &ec2.ModifyTrafficMirrorFilterNetworkServicesInput{
 			TrafficMirrorFilterId: aws.String(d.Id()),
 		}
 
+		// Removing the argument entirely yields an empty new set, so every
+		// previously configured service is removed. Services that are now
+		// ignored are left as they are.
 		o, n := d.GetChange("network_services")
-		newServices := n.(*schema.Set).Difference(o.(*schema.Set))
-		if newServices.Len() > 0 {
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+		ignoreSet := d.Get("ignore_network_services").(*schema.Set)
+
+		if newServices := newSet.Difference(oldSet); newServices.Len() > 0 {
 			input.AddNetworkServices = flex.ExpandStringSet(newServices)
 		}
 
-		removeServices := o.(*schema.Set).Difference(n.(*schema.Set))
-		if removeServices.Len() > 0 {
+		if removeServices := oldSet.Difference(newSet).Difference(ignoreSet); removeServices.Len() > 0 {
 			input.RemoveNetworkServices = flex.ExpandStringSet(removeServices)
 		}
 
-		_, err := conn.ModifyTrafficMirrorFilterNetworkServices(input)
-		if err != nil {
-			return fmt.Errorf("error modifying EC2 Traffic Mirror Filter (%s) network services: %w", d.Id(), err)
+		if len(input.AddNetworkServices) > 0 || len(input.RemoveNetworkServices) > 0 {
+			_, err := conn.ModifyTrafficMirrorFilterNetworkServices(input)
+			if err != nil {
+				return fmt.Errorf("error modifying EC2 Traffic Mirror Filter (%s) network services: %w", d.Id(), err)
+			}
 		}
 	}
 
@@ -132,6 +259,31 @@ func resourceTrafficMirrorFilterRead(d *schema.ResourceData, meta interface{}) e
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	if d.Get("dry_run").(bool) {
+		tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{}))).IgnoreConfig(ignoreTagsConfig)
+
+		if err := d.Set("tags_all", tags.Map()); err != nil {
+			return fmt.Errorf("error setting tags_all: %w", err)
+		}
+
+		arn, err := meta.(*conns.AWSClient).RegionalARN(ec2.ServiceName, fmt.Sprintf("traffic-mirror-filter/%s", d.Id()))
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s): %w", d.Id(), err)
+		}
+
+		d.Set("rule_count", 0)
+
+		if d.Get("count_sessions").(bool) {
+			d.Set("session_count", 0)
+		} else {
+			d.Set("session_count", nil)
+		}
+		d.Set("arn", arn)
+
+		return nil
+	}
+
 	input := &ec2.DescribeTrafficMirrorFiltersInput{
 		TrafficMirrorFilterIds: aws.StringSlice([]string{d.Id()}),
 	}
@@ -160,7 +312,7 @@ func resourceTrafficMirrorFilterRead(d *schema.ResourceData, meta interface{}) e
 	tags := KeyValueTags(trafficMirrorFilter.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfigExcept(defaultTagsConfig, tftags.New(d.Get("tags").(map[string]interface{}))).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -168,17 +320,59 @@ func resourceTrafficMirrorFilterRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("error setting tags_all: %w", err)
 	}
 
-	if err := d.Set("network_services", aws.StringValueSlice(trafficMirrorFilter.NetworkServices)); err != nil {
+	// Ignored services are managed outside of Terraform, so they are left out of state.
+	networkServices := flattenTrafficMirrorFilterNetworkServices(trafficMirrorFilter.NetworkServices, d.Get("ignore_network_services").(*schema.Set))
+
+	// Managed services removed outside of Terraform are dropped from state,
+	// so that the next plan adds them back.
+	if !d.IsNewResource() {
+		readNetworkServices := flex.FlattenStringSet(aws.StringSlice(networkServices))
+
+		for _, v := range d.Get("network_services").(*schema.Set).List() {
+			if !readNetworkServices.Contains(v) {
+				log.Printf("[WARN] EC2 Traffic Mirror Filter (%s) network service %q was removed outside of Terraform", d.Id(), v)
+			}
+		}
+	}
+
+	if err := d.Set("network_services", networkServices); err != nil {
 		return fmt.Errorf("error setting network_services for filter %v: %s", d.Id(), err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   ec2.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("traffic-mirror-filter/%s", d.Id()),
-	}.String()
+	// Imported filters, and filters created before strict_network_services
+	// was added, have no value in state yet.
+	if _, ok := d.GetOkExists("strict_network_services"); !ok {
+		d.Set("strict_network_services", true)
+	}
+
+	if _, ok := d.GetOkExists("count_sessions"); !ok {
+		d.Set("count_sessions", false)
+	}
+
+	if _, ok := d.GetOkExists("prevent_destroy_with_sessions"); !ok {
+		d.Set("prevent_destroy_with_sessions", false)
+	}
+
+	d.Set("rule_count", len(trafficMirrorFilter.IngressFilterRules)+len(trafficMirrorFilter.EgressFilterRules))
+
+	// Counting sessions takes additional calls and permissions, so it is only done on request.
+	if d.Get("count_sessions").(bool) {
+		sessionCount, err := countTrafficMirrorSessionsByFilterID(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s) sessions: %w", d.Id(), err)
+		}
+
+		d.Set("session_count", sessionCount)
+	} else {
+		d.Set("session_count", nil)
+	}
+
+	arn, err := meta.(*conns.AWSClient).RegionalARN(ec2.ServiceName, fmt.Sprintf("traffic-mirror-filter/%s", d.Id()))
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s): %w", d.Id(), err)
+	}
 
 	d.Set("arn", arn)
 
@@ -188,14 +382,95 @@ func resourceTrafficMirrorFilterRead(d *schema.ResourceData, meta interface{}) e
 func resourceTrafficMirrorFilterDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
+	if d.Get("dry_run").(bool) {
+		return nil
+	}
+
+	// Sessions are not deleted with the filter they use, so warn when any
+	// still do, or refuse to delete the filter if asked to.
+	preventDestroy := d.Get("prevent_destroy_with_sessions").(bool)
+
+	if sessionCount, err := countTrafficMirrorSessionsByFilterID(conn, d.Id()); err != nil {
+		if preventDestroy {
+			return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s) sessions: %w", d.Id(), err)
+		}
+
+		log.Printf("[WARN] Unable to check EC2 Traffic Mirror Filter (%s) for sessions: %s", d.Id(), err)
+	} else if sessionCount > 0 {
+		if preventDestroy {
+			return fmt.Errorf("EC2 Traffic Mirror Filter (%s) is still used by %d Traffic Mirror Session(s), delete them first or set prevent_destroy_with_sessions to false", d.Id(), sessionCount)
+		}
+
+		log.Printf("[WARN] EC2 Traffic Mirror Filter (%s) is still used by %d Traffic Mirror Session(s)", d.Id(), sessionCount)
+	}
+
 	input := &ec2.DeleteTrafficMirrorFilterInput{
 		TrafficMirrorFilterId: aws.String(d.Id()),
 	}
 
 	_, err := conn.DeleteTrafficMirrorFilter(input)
+
+	// The filter may have been deleted outside Terraform since it was read.
+	if tfawserr.ErrCodeEquals(err, "InvalidTrafficMirrorFilterId.NotFound") {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter (%s) already deleted", d.Id())
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("Error deleting traffic mirror filter %v: %v", d.Id(), err)
 	}
 
 	return nil
 }
+
+// flattenTrafficMirrorFilterNetworkServices returns the distinct network
+// services, other than those in ignore, sorted so that they are in the same
+// order regardless of the order returned by the API.
+func flattenTrafficMirrorFilterNetworkServices(apiObjects []*string, ignore *schema.Set) []string {
+	seen := make(map[string]bool)
+	var networkServices []string
+
+	for _, v := range aws.StringValueSlice(apiObjects) {
+		if seen[v] || (ignore != nil && ignore.Contains(v)) {
+			continue
+		}
+
+		seen[v] = true
+		networkServices = append(networkServices, v)
+	}
+
+	sort.Strings(networkServices)
+
+	return networkServices
+}
+
+// countTrafficMirrorSessionsByFilterID returns the number of traffic mirror
+// sessions using the specified filter.
+func countTrafficMirrorSessionsByFilterID(conn *ec2.EC2, filterID string) (int, error) {
+	input := &ec2.DescribeTrafficMirrorSessionsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"traffic-mirror-filter-id": filterID,
+		}),
+	}
+	var count int
+
+	err := conn.DescribeTrafficMirrorSessionsPages(input, func(page *ec2.DescribeTrafficMirrorSessionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TrafficMirrorSessions {
+			if v != nil {
+				count++
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,100 @@
+package ec2
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceTrafficMirrorFilter() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTrafficMirrorFilterRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter": DataSourceFiltersSchema(),
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"network_services": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceTrafficMirrorFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeTrafficMirrorFiltersInput{}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filters = BuildFiltersDataSource(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("id"); ok {
+		input.TrafficMirrorFilterIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Traffic Mirror Filters: %s", input)
+	output, err := conn.DescribeTrafficMirrorFilters(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filter: %w", err)
+	}
+
+	if output == nil || len(output.TrafficMirrorFilters) == 0 {
+		return errors.New("error reading EC2 Traffic Mirror Filter: no results found")
+	}
+
+	if len(output.TrafficMirrorFilters) > 1 {
+		return errors.New("error reading EC2 Traffic Mirror Filter: multiple results found, try adjusting search criteria")
+	}
+
+	trafficMirrorFilter := output.TrafficMirrorFilters[0]
+
+	if trafficMirrorFilter == nil {
+		return errors.New("error reading EC2 Traffic Mirror Filter: empty result")
+	}
+
+	d.SetId(aws.StringValue(trafficMirrorFilter.TrafficMirrorFilterId))
+
+	d.Set("description", trafficMirrorFilter.Description)
+
+	if err := d.Set("network_services", flattenTrafficMirrorFilterNetworkServices(trafficMirrorFilter.NetworkServices, nil)); err != nil {
+		return fmt.Errorf("error setting network_services: %w", err)
+	}
+
+	if err := d.Set("tags", KeyValueTags(trafficMirrorFilter.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	arn, err := meta.(*conns.AWSClient).RegionalARN(ec2.ServiceName, fmt.Sprintf("traffic-mirror-filter/%s", d.Id()))
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", arn)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package ec2_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestTrafficMirrorFilterDataSourceRead_networkServicesOrder(t *testing.T) {
+	// The API returns the same network services in a different order on each read.
+	responses := [][]string{
+		{"aws-dns", "amazon-dns"},
+		{"amazon-dns", "aws-dns"},
+	}
+	var reads int
+
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *ec2.DescribeTrafficMirrorFiltersOutput:
+			data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{
+				{
+					TrafficMirrorFilterId: aws.String("tmf-12345678"),
+					NetworkServices:       aws.StringSlice(responses[reads%len(responses)]),
+				},
+			}
+			reads++
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		EC2Conn:   conn,
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	ds := tfec2.DataSourceTrafficMirrorFilter()
+
+	var states []map[string]string
+
+	for range responses {
+		d := ds.TestResourceData()
+		d.Set("id", "tmf-12345678")
+
+		if err := ds.Read(d, meta); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		states = append(states, d.State().Attributes)
+	}
+
+	if got := states[0]["network_services.#"]; got != "2" {
+		t.Fatalf("got %s network_services, expected 2", got)
+	}
+
+	if !reflect.DeepEqual(states[0], states[1]) {
+		t.Errorf("expected the same state across reads, got %v and %v", states[0], states[1])
+	}
+}
+
+func TestAccEC2TrafficMirrorFilterDataSource_filter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_traffic_mirror_filter.test"
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficMirrorFilterFilterDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_services.#", resourceName, "network_services.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrafficMirrorFilterFilterDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  description      = "test filter"
+  network_services = ["amazon-dns"]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_traffic_mirror_filter" "test" {
+  filter {
+    name   = "tag:Name"
+    values = [aws_ec2_traffic_mirror_filter.test.tags["Name"]]
+  }
+}
+`, rName)
+}
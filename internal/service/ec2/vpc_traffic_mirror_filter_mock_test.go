@@ -0,0 +1,917 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestResourceTrafficMirrorFilterUpdate_removeNetworkServices(t *testing.T) {
+	var modifyInput *ec2.ModifyTrafficMirrorFilterNetworkServicesInput
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *ec2.ModifyTrafficMirrorFilterNetworkServicesOutput:
+			modifyInput = r.Params.(*ec2.ModifyTrafficMirrorFilterNetworkServicesInput)
+		case *ec2.DescribeTrafficMirrorFiltersOutput:
+			data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{
+				{
+					TrafficMirrorFilterId: aws.String("tmf-12345678"),
+				},
+			}
+		case *ec2.DescribeTrafficMirrorSessionsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		EC2Conn:   conn,
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	r := tfec2.ResourceTrafficMirrorFilter()
+	state := &terraform.InstanceState{
+		ID: "tmf-12345678",
+		Attributes: map[string]string{
+			"id":                 "tmf-12345678",
+			"network_services.#": "1",
+			"network_services.0": "amazon-dns",
+			"tags.%":             "0",
+			"tags_all.%":         "0",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{})
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff == nil || diff.Empty() {
+		t.Fatal("expected a diff removing network_services, got none")
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if modifyInput == nil {
+		t.Fatal("expected ModifyTrafficMirrorFilterNetworkServices to be called")
+	}
+
+	if got := aws.StringValueSlice(modifyInput.RemoveNetworkServices); !reflect.DeepEqual(got, []string{"amazon-dns"}) {
+		t.Errorf("got RemoveNetworkServices %v, expected [amazon-dns]", got)
+	}
+
+	if got := len(modifyInput.AddNetworkServices); got != 0 {
+		t.Errorf("got %d AddNetworkServices, expected 0", got)
+	}
+
+	if got := newState.Attributes["network_services.#"]; got != "" && got != "0" {
+		t.Errorf("got network_services.# %s, expected 0", got)
+	}
+}
+
+func TestResourceTrafficMirrorFilterUpdate_networkServicesKeepTags(t *testing.T) {
+	var networkServices []*string
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *ec2.ModifyTrafficMirrorFilterNetworkServicesOutput:
+			networkServices = r.Params.(*ec2.ModifyTrafficMirrorFilterNetworkServicesInput).AddNetworkServices
+		case *ec2.DescribeTrafficMirrorFiltersOutput:
+			data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{
+				{
+					TrafficMirrorFilterId: aws.String("tmf-12345678"),
+					NetworkServices:       networkServices,
+					Tags: []*ec2.Tag{
+						{Key: aws.String("Name"), Value: aws.String("test")},
+						{Key: aws.String("env"), Value: aws.String("prod")},
+					},
+				},
+			}
+		case *ec2.DescribeTrafficMirrorSessionsOutput:
+		default:
+			// Including tagging calls, which would rewrite the unchanged tags.
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		EC2Conn:   conn,
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	r := tfec2.ResourceTrafficMirrorFilter()
+	state := &terraform.InstanceState{
+		ID: "tmf-12345678",
+		Attributes: map[string]string{
+			"id":            "tmf-12345678",
+			"tags.%":        "2",
+			"tags.Name":     "test",
+			"tags.env":      "prod",
+			"tags_all.%":    "2",
+			"tags_all.Name": "test",
+			"tags_all.env":  "prod",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"network_services": []interface{}{"amazon-dns"},
+		"tags": map[string]interface{}{
+			"Name": "test",
+			"env":  "prod",
+		},
+	})
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for k := range diff.Attributes {
+		if strings.HasPrefix(k, "tags") {
+			t.Errorf("unexpected diff for %s: %#v", k, diff.Attributes[k])
+		}
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for k, expected := range map[string]string{
+		"network_services.#": "1",
+		"tags.%":             "2",
+		"tags.Name":          "test",
+		"tags.env":           "prod",
+		"tags_all.%":         "2",
+		"tags_all.Name":      "test",
+		"tags_all.env":       "prod",
+	} {
+		if got := newState.Attributes[k]; got != expected {
+			t.Errorf("got %s %q, expected %q", k, got, expected)
+		}
+	}
+
+	if diff, err := r.Diff(context.Background(), newState, config, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff after update, got: %#v", diff.Attributes)
+	}
+}
+
+func TestResourceTrafficMirrorFilterRead_ignoreNetworkServices(t *testing.T) {
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *ec2.DescribeTrafficMirrorFiltersOutput:
+			// amazon-dns was enabled by another automation.
+			data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{
+				{
+					TrafficMirrorFilterId: aws.String("tmf-12345678"),
+					NetworkServices:       aws.StringSlice([]string{"amazon-dns"}),
+				},
+			}
+		case *ec2.DescribeTrafficMirrorSessionsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		EC2Conn:   conn,
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	r := tfec2.ResourceTrafficMirrorFilter()
+	d := r.TestResourceData()
+	d.SetId("tmf-12345678")
+	d.Set("ignore_network_services", []interface{}{"amazon-dns"})
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := d.Get("network_services").(*schema.Set).Len(); got != 0 {
+		t.Errorf("got %d network_services in state, expected ignored amazon-dns to be left out", got)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"ignore_network_services": []interface{}{"amazon-dns"},
+	})
+
+	diff, err := r.Diff(context.Background(), d.State(), config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no drift for an ignored network service, got diff: %#v", diff.Attributes)
+	}
+}
+
+func TestResourceTrafficMirrorFilterRead_defaultTagsOverlap(t *testing.T) {
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *ec2.DescribeTrafficMirrorFiltersOutput:
+			data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{
+				{
+					TrafficMirrorFilterId: aws.String("tmf-12345678"),
+					Tags: []*ec2.Tag{
+						{Key: aws.String("Environment"), Value: aws.String("production")},
+						{Key: aws.String("Name"), Value: aws.String("test")},
+						{Key: aws.String("Owner"), Value: aws.String("network")},
+						{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("test")},
+						{Key: aws.String("ignored"), Value: aws.String("value")},
+					},
+				},
+			}
+		case *ec2.DescribeTrafficMirrorSessionsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags: tftags.New(map[string]interface{}{
+				"Environment": "production",
+				"Owner":       "network",
+			}),
+		},
+		EC2Conn: conn,
+		IgnoreTagsConfig: &tftags.IgnoreConfig{
+			Keys: tftags.New([]interface{}{"ignored"}),
+		},
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	testCases := []struct {
+		Name         string
+		Tags         map[string]interface{}
+		ExpectedTags map[string]interface{}
+	}{
+		{
+			Name:         "default tags set only by the provider",
+			Tags:         map[string]interface{}{"Name": "test"},
+			ExpectedTags: map[string]interface{}{"Name": "test"},
+		},
+		{
+			Name:         "resource tag shadowing a default tag",
+			Tags:         map[string]interface{}{"Name": "test", "Owner": "network"},
+			ExpectedTags: map[string]interface{}{"Name": "test", "Owner": "network"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := tfec2.ResourceTrafficMirrorFilter()
+			d := r.TestResourceData()
+			d.SetId("tmf-12345678")
+			d.Set("tags", testCase.Tags)
+
+			if err := r.Read(d, meta); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(got, testCase.ExpectedTags) {
+				t.Errorf("got tags %v, expected %v", got, testCase.ExpectedTags)
+			}
+
+			if got, want := d.Get("tags_all").(map[string]interface{}), map[string]interface{}{"Environment": "production", "Name": "test", "Owner": "network"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got tags_all %v, expected %v", got, want)
+			}
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"tags": testCase.Tags,
+			})
+
+			diff, err := r.Diff(context.Background(), d.State(), config, meta)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff != nil && !diff.Empty() {
+				t.Errorf("expected no drift, got diff: %#v", diff.Attributes)
+			}
+		})
+	}
+}
+
+func TestResourceTrafficMirrorFilterRead_networkServiceRemovedExternally(t *testing.T) {
+	var modifyInput *ec2.ModifyTrafficMirrorFilterNetworkServicesInput
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *ec2.ModifyTrafficMirrorFilterNetworkServicesOutput:
+			modifyInput = r.Params.(*ec2.ModifyTrafficMirrorFilterNetworkServicesInput)
+		case *ec2.DescribeTrafficMirrorFiltersOutput:
+			trafficMirrorFilter := &ec2.TrafficMirrorFilter{
+				TrafficMirrorFilterId: aws.String("tmf-12345678"),
+			}
+
+			// amazon-dns was removed by another automation until it is added back.
+			if modifyInput != nil {
+				trafficMirrorFilter.NetworkServices = modifyInput.AddNetworkServices
+			}
+
+			data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{trafficMirrorFilter}
+		case *ec2.DescribeTrafficMirrorSessionsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		EC2Conn:   conn,
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	r := tfec2.ResourceTrafficMirrorFilter()
+	d := r.TestResourceData()
+	d.SetId("tmf-12345678")
+	d.Set("network_services", []interface{}{"amazon-dns"})
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := d.Get("network_services").(*schema.Set).Len(); got != 0 {
+		t.Fatalf("got %d network_services in state, expected the removed amazon-dns to be left out", got)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"network_services": []interface{}{"amazon-dns"},
+	})
+
+	diff, err := r.Diff(context.Background(), d.State(), config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff == nil || diff.Empty() {
+		t.Fatal("expected a diff adding back network_services, got none")
+	}
+
+	newState, diags := r.Apply(context.Background(), d.State(), diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if modifyInput == nil {
+		t.Fatal("expected ModifyTrafficMirrorFilterNetworkServices to be called")
+	}
+
+	if got := aws.StringValueSlice(modifyInput.AddNetworkServices); !reflect.DeepEqual(got, []string{"amazon-dns"}) {
+		t.Errorf("got AddNetworkServices %v, expected [amazon-dns]", got)
+	}
+
+	if got := len(modifyInput.RemoveNetworkServices); got != 0 {
+		t.Errorf("got %d RemoveNetworkServices, expected 0", got)
+	}
+
+	if got := newState.Attributes["network_services.#"]; got != "1" {
+		t.Errorf("got network_services.# %s, expected 1", got)
+	}
+}
+
+func TestResourceTrafficMirrorFilterRead_sessionCount(t *testing.T) {
+	var sessionsInputs []*ec2.DescribeTrafficMirrorSessionsInput
+	var deleted bool
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *ec2.DescribeTrafficMirrorFiltersOutput:
+			data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{
+				{
+					TrafficMirrorFilterId: aws.String("tmf-12345678"),
+				},
+			}
+		case *ec2.DescribeTrafficMirrorSessionsOutput:
+			input := r.Params.(*ec2.DescribeTrafficMirrorSessionsInput)
+			sessionsInputs = append(sessionsInputs, input)
+
+			if aws.StringValue(input.NextToken) == "" {
+				data.TrafficMirrorSessions = []*ec2.TrafficMirrorSession{
+					{TrafficMirrorSessionId: aws.String("tms-11111111")},
+					{TrafficMirrorSessionId: aws.String("tms-22222222")},
+				}
+				data.NextToken = aws.String("page2")
+				return
+			}
+
+			data.TrafficMirrorSessions = []*ec2.TrafficMirrorSession{
+				{TrafficMirrorSessionId: aws.String("tms-33333333")},
+			}
+		case *ec2.DeleteTrafficMirrorFilterOutput:
+			deleted = true
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		EC2Conn:   conn,
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	r := tfec2.ResourceTrafficMirrorFilter()
+	d := r.TestResourceData()
+	d.SetId("tmf-12345678")
+	d.Set("count_sessions", true)
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := d.Get("session_count").(int), 3; got != expected {
+		t.Errorf("got session_count %d, expected %d", got, expected)
+	}
+
+	expectedFilters := []*ec2.Filter{
+		{
+			Name:   aws.String("traffic-mirror-filter-id"),
+			Values: aws.StringSlice([]string{"tmf-12345678"}),
+		},
+	}
+
+	for _, input := range sessionsInputs {
+		if !reflect.DeepEqual(input.Filters, expectedFilters) {
+			t.Errorf("got DescribeTrafficMirrorSessions filters %v, expected %v", input.Filters, expectedFilters)
+		}
+	}
+
+	// Sessions still using the filter only warn, they do not prevent the delete.
+	if err := r.Delete(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !deleted {
+		t.Error("expected DeleteTrafficMirrorFilter to be called")
+	}
+}
+
+func TestResourceTrafficMirrorFilterRead_sessionCountNotRequested(t *testing.T) {
+	var sessionsErr error
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *ec2.DescribeTrafficMirrorFiltersOutput:
+			data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{
+				{
+					TrafficMirrorFilterId: aws.String("tmf-12345678"),
+					EgressFilterRules: []*ec2.TrafficMirrorFilterRule{
+						{TrafficMirrorFilterRuleId: aws.String("tmfr-12345678")},
+					},
+				},
+			}
+		case *ec2.DescribeTrafficMirrorSessionsOutput:
+			if sessionsErr == nil {
+				t.Error("unexpected DescribeTrafficMirrorSessions call without count_sessions")
+				return
+			}
+
+			r.Error = sessionsErr
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		EC2Conn:   conn,
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	r := tfec2.ResourceTrafficMirrorFilter()
+	d := r.TestResourceData()
+	d.SetId("tmf-12345678")
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := d.Get("session_count").(int); got != 0 {
+		t.Errorf("got session_count %d, expected it to be left unset", got)
+	}
+
+	if got, expected := d.Get("rule_count").(int), 1; got != expected {
+		t.Errorf("got rule_count %d, expected %d", got, expected)
+	}
+
+	// Once requested, failing to count the sessions is an error.
+	sessionsErr = awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil)
+	d.Set("count_sessions", true)
+
+	if err := r.Read(d, meta); err == nil || !strings.Contains(err.Error(), "UnauthorizedOperation") {
+		t.Fatalf("expected UnauthorizedOperation error, got: %v", err)
+	}
+}
+
+func TestResourceTrafficMirrorFilterDelete_preventDestroyWithSessions(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		PreventDestroy bool
+		Sessions       []*ec2.TrafficMirrorSession
+		ExpectedError  *regexp.Regexp
+	}{
+		{
+			Name:     "sessions not prevented",
+			Sessions: []*ec2.TrafficMirrorSession{{TrafficMirrorSessionId: aws.String("tms-11111111")}},
+		},
+		{
+			Name:           "no sessions",
+			PreventDestroy: true,
+		},
+		{
+			Name:           "sessions",
+			PreventDestroy: true,
+			Sessions:       []*ec2.TrafficMirrorSession{{TrafficMirrorSessionId: aws.String("tms-11111111")}},
+			ExpectedError:  regexp.MustCompile(`EC2 Traffic Mirror Filter \(tmf-12345678\) is still used by 1 Traffic Mirror Session\(s\)`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var deleted bool
+			conn := newMockEC2Conn(t, func(r *request.Request) {
+				switch data := r.Data.(type) {
+				case *ec2.DescribeTrafficMirrorSessionsOutput:
+					data.TrafficMirrorSessions = testCase.Sessions
+				case *ec2.DeleteTrafficMirrorFilterOutput:
+					deleted = true
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+
+			meta := &conns.AWSClient{
+				AccountID: "123456789012",
+				EC2Conn:   conn,
+				Partition: "aws",
+				Region:    "us-west-2",
+			}
+
+			r := tfec2.ResourceTrafficMirrorFilter()
+			d := r.TestResourceData()
+			d.SetId("tmf-12345678")
+			d.Set("prevent_destroy_with_sessions", testCase.PreventDestroy)
+
+			err := r.Delete(d, meta)
+
+			if testCase.ExpectedError != nil {
+				if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				if deleted {
+					t.Error("expected DeleteTrafficMirrorFilter not to be called")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !deleted {
+				t.Error("expected DeleteTrafficMirrorFilter to be called")
+			}
+		})
+	}
+}
+
+func TestResourceTrafficMirrorFilterDelete_notFound(t *testing.T) {
+	var deleteCalls int
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch r.Data.(type) {
+		case *ec2.DescribeTrafficMirrorSessionsOutput:
+		case *ec2.DeleteTrafficMirrorFilterOutput:
+			deleteCalls++
+			r.Error = awserr.New("InvalidTrafficMirrorFilterId.NotFound", "The traffic mirror filter 'tmf-12345678' does not exist.", nil)
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		EC2Conn:   conn,
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	r := tfec2.ResourceTrafficMirrorFilter()
+	d := r.TestResourceData()
+	d.SetId("tmf-12345678")
+
+	if err := r.Delete(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if deleteCalls != 1 {
+		t.Errorf("got %d DeleteTrafficMirrorFilter calls, expected 1", deleteCalls)
+	}
+}
+
+func TestResourceTrafficMirrorFilterDiff_ignoreNetworkServicesOverlap(t *testing.T) {
+	r := tfec2.ResourceTrafficMirrorFilter()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"ignore_network_services": []interface{}{"amazon-dns"},
+		"network_services":        []interface{}{"amazon-dns"},
+	})
+
+	_, err := r.Diff(context.Background(), nil, config, &conns.AWSClient{})
+
+	if err == nil || !regexp.MustCompile(`both in network_services and ignore_network_services`).MatchString(err.Error()) {
+		t.Fatalf("expected overlap error, got: %v", err)
+	}
+}
+
+func TestResourceTrafficMirrorFilterDiff_strictNetworkServices(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		State         map[string]string
+		Config        map[string]interface{}
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name: "strict known service",
+			Config: map[string]interface{}{
+				"network_services": []interface{}{"amazon-dns"},
+			},
+		},
+		{
+			Name: "strict unknown service",
+			Config: map[string]interface{}{
+				"network_services": []interface{}{"amazon-new-service"},
+			},
+			ExpectedError: regexp.MustCompile(`expected network_services to be one of \[amazon-dns\], got amazon-new-service, or set strict_network_services to false`),
+		},
+		{
+			Name: "strict unknown ignored service",
+			Config: map[string]interface{}{
+				"ignore_network_services": []interface{}{"amazon-new-service"},
+				"strict_network_services": true,
+			},
+			ExpectedError: regexp.MustCompile(`expected ignore_network_services to be one of`),
+		},
+		{
+			Name: "strict unknown service in state",
+			State: map[string]string{
+				"network_services.#": "1",
+				fmt.Sprintf("network_services.%d", schema.HashString("amazon-new-service")): "amazon-new-service",
+				"strict_network_services": "false",
+			},
+			Config: map[string]interface{}{
+				"network_services":        []interface{}{"amazon-new-service"},
+				"strict_network_services": true,
+			},
+			ExpectedError: regexp.MustCompile(`expected network_services to be one of`),
+		},
+		{
+			Name: "lenient unknown service",
+			Config: map[string]interface{}{
+				"network_services":        []interface{}{"amazon-new-service"},
+				"strict_network_services": false,
+			},
+		},
+		{
+			Name: "lenient unknown ignored service",
+			Config: map[string]interface{}{
+				"ignore_network_services": []interface{}{"amazon-new-service"},
+				"strict_network_services": false,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := tfec2.ResourceTrafficMirrorFilter()
+
+			var state *terraform.InstanceState
+			if testCase.State != nil {
+				state = &terraform.InstanceState{
+					ID:         "tmf-12345678",
+					Attributes: testCase.State,
+				}
+			}
+
+			_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(testCase.Config), &conns.AWSClient{})
+
+			if testCase.ExpectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestResourceTrafficMirrorFilterCreate_clientToken(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ClientToken string
+	}{
+		{
+			Name:        "explicit",
+			ClientToken: "test-token",
+		},
+		{
+			Name: "generated",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var clientTokens []string
+			conn := newMockEC2Conn(t, func(r *request.Request) {
+				switch data := r.Data.(type) {
+				case *ec2.CreateTrafficMirrorFilterOutput:
+					clientTokens = append(clientTokens, aws.StringValue(r.Params.(*ec2.CreateTrafficMirrorFilterInput).ClientToken))
+
+					data.TrafficMirrorFilter = &ec2.TrafficMirrorFilter{
+						TrafficMirrorFilterId: aws.String("tmf-12345678"),
+					}
+				case *ec2.DescribeTrafficMirrorFiltersOutput:
+					data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{
+						{
+							TrafficMirrorFilterId: aws.String("tmf-12345678"),
+						},
+					}
+				case *ec2.DescribeTrafficMirrorSessionsOutput:
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+
+			meta := &conns.AWSClient{
+				AccountID: "123456789012",
+				EC2Conn:   conn,
+				Partition: "aws",
+				Region:    "us-west-2",
+			}
+
+			r := tfec2.ResourceTrafficMirrorFilter()
+			d := r.TestResourceData()
+
+			if testCase.ClientToken != "" {
+				d.Set("client_token", testCase.ClientToken)
+			}
+
+			if err := r.Create(d, meta); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(clientTokens) != 1 {
+				t.Fatalf("got %d CreateTrafficMirrorFilter calls, expected 1", len(clientTokens))
+			}
+
+			clientToken := clientTokens[0]
+
+			if clientToken == "" {
+				t.Fatal("expected CreateTrafficMirrorFilter to be called with a ClientToken")
+			}
+
+			if testCase.ClientToken != "" && clientToken != testCase.ClientToken {
+				t.Errorf("got ClientToken %s, expected %s", clientToken, testCase.ClientToken)
+			}
+
+			if got := d.Get("client_token").(string); got != clientToken {
+				t.Errorf("got client_token %s in state, expected %s", got, clientToken)
+			}
+		})
+	}
+}
+
+func TestResourceTrafficMirrorFilterCreate_dryRun(t *testing.T) {
+	var createCalls int
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch r.Data.(type) {
+		case *ec2.CreateTrafficMirrorFilterOutput:
+			createCalls++
+
+			if !aws.BoolValue(r.Params.(*ec2.CreateTrafficMirrorFilterInput).DryRun) {
+				t.Error("expected CreateTrafficMirrorFilter to be called with DryRun")
+			}
+
+			r.Error = awserr.New(tfec2.ErrCodeDryRunOperation, "Request would have succeeded, but DryRun flag is set.", nil)
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		EC2Conn:   conn,
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	r := tfec2.ResourceTrafficMirrorFilter()
+	d := r.TestResourceData()
+	d.Set("description", "test filter")
+	d.Set("dry_run", true)
+	d.Set("network_services", []interface{}{"amazon-dns"})
+
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if createCalls != 1 {
+		t.Errorf("got %d CreateTrafficMirrorFilter calls, expected 1", createCalls)
+	}
+
+	if !regexp.MustCompile(`^tmf-dryrun-`).MatchString(d.Id()) {
+		t.Errorf("got ID %q, expected a synthetic dry-run ID", d.Id())
+	}
+
+	if err := r.Delete(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if createCalls != 1 {
+		t.Errorf("got %d CreateTrafficMirrorFilter calls after delete, expected 1", createCalls)
+	}
+}
+
+func TestResourceTrafficMirrorFilterCreate_dryRunUnauthorized(t *testing.T) {
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch r.Data.(type) {
+		case *ec2.CreateTrafficMirrorFilterOutput:
+			r.Error = awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil)
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	r := tfec2.ResourceTrafficMirrorFilter()
+	d := r.TestResourceData()
+	d.Set("dry_run", true)
+
+	err := r.Create(d, &conns.AWSClient{EC2Conn: conn})
+
+	if err == nil || !regexp.MustCompile(`UnauthorizedOperation`).MatchString(err.Error()) {
+		t.Fatalf("expected UnauthorizedOperation error, got: %v", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected no ID to be set, got %s", d.Id())
+	}
+}
+
+// newMockEC2Conn returns an EC2 client that hands every request to send instead
+// of calling AWS. send fills in the request's output or sets its error.
+func newMockEC2Conn(t *testing.T, send func(r *request.Request)) *ec2.EC2 {
+	t.Helper()
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := ec2.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(send)
+
+	return conn
+}
@@ -0,0 +1,53 @@
+package ec2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenTrafficMirrorFilterNetworkServices(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    []string
+		Ignore   *schema.Set
+		Expected []string
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:     "sorted",
+			Input:    []string{"amazon-dns", "aws-dns"},
+			Expected: []string{"amazon-dns", "aws-dns"},
+		},
+		{
+			Name:     "unsorted",
+			Input:    []string{"aws-dns", "amazon-dns"},
+			Expected: []string{"amazon-dns", "aws-dns"},
+		},
+		{
+			Name:     "duplicates",
+			Input:    []string{"aws-dns", "amazon-dns", "aws-dns"},
+			Expected: []string{"amazon-dns", "aws-dns"},
+		},
+		{
+			Name:     "ignored",
+			Input:    []string{"aws-dns", "amazon-dns"},
+			Ignore:   schema.NewSet(schema.HashString, []interface{}{"amazon-dns"}),
+			Expected: []string{"aws-dns"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenTrafficMirrorFilterNetworkServices(aws.StringSlice(testCase.Input), testCase.Ignore)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
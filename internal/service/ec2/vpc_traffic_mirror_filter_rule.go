@@ -3,17 +3,37 @@ package ec2
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	trafficMirrorFilterRuleNumberMin = 1
+	trafficMirrorFilterRuleNumberMax = 32766
+
+	// Maximum amount of time to keep assigning a new rule number when the
+	// assigned one was taken by a rule created outside this provider run
+	trafficMirrorFilterRuleNumberConflictTimeout = 2 * time.Minute
+)
+
+var (
+	trafficMirrorFilterIDRegexp     = regexp.MustCompile(`^tmf-[0-9a-f]+$`)
+	trafficMirrorFilterRuleIDRegexp = regexp.MustCompile(`^tmfr-[0-9a-f]+$`)
+)
+
 func ResourceTrafficMirrorFilterRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTrafficMirrorFilterRuleCreate,
@@ -62,8 +82,16 @@ func ResourceTrafficMirrorFilterRule() *schema.Resource {
 				},
 			},
 			"protocol": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ConflictsWith:    []string{"protocol_name"},
+				DiffSuppressFunc: suppressTrafficMirrorFilterRuleProtocolSetByName,
+			},
+			"protocol_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"protocol"},
+				ValidateFunc:  validation.StringInSlice(trafficMirrorFilterRuleProtocolNameValues(), false),
 			},
 			"rule_action": {
 				Type:     schema.TypeString,
@@ -74,8 +102,10 @@ func ResourceTrafficMirrorFilterRule() *schema.Resource {
 				}, false),
 			},
 			"rule_number": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(trafficMirrorFilterRuleNumberMin, trafficMirrorFilterRuleNumberMax),
 			},
 			"source_cidr_block": {
 				Type:         schema.TypeString,
@@ -123,7 +153,6 @@ func resourceTrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interfac
 		DestinationCidrBlock:  aws.String(d.Get("destination_cidr_block").(string)),
 		SourceCidrBlock:       aws.String(d.Get("source_cidr_block").(string)),
 		RuleAction:            aws.String(d.Get("rule_action").(string)),
-		RuleNumber:            aws.Int64(int64(d.Get("rule_number").(int))),
 		TrafficDirection:      aws.String(d.Get("traffic_direction").(string)),
 	}
 
@@ -131,8 +160,8 @@ func resourceTrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interfac
 		input.Description = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("protocol"); ok {
-		input.Protocol = aws.Int64(int64(v.(int)))
+	if protocol := trafficMirrorFilterRuleProtocol(d); protocol > 0 {
+		input.Protocol = aws.Int64(int64(protocol))
 	}
 
 	if v, ok := d.GetOk("destination_port_range"); ok {
@@ -143,7 +172,18 @@ func resourceTrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interfac
 		input.SourcePortRange = buildTrafficMirrorPortRangeRequest(v.([]interface{}))
 	}
 
-	out, err := conn.CreateTrafficMirrorFilterRule(input)
+	var out *ec2.CreateTrafficMirrorFilterRuleOutput
+	var err error
+
+	if v, ok := d.GetOk("rule_number"); ok {
+		// A number that is already used in the direction is rejected by EC2.
+		input.RuleNumber = aws.Int64(int64(v.(int)))
+
+		out, err = conn.CreateTrafficMirrorFilterRule(input)
+	} else {
+		out, err = createTrafficMirrorFilterRuleWithNextNumber(conn, input)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error creating EC2 Traffic Mirror Filter Rule (%s): %w", filterId, err)
 	}
@@ -152,6 +192,73 @@ func resourceTrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interfac
 	return resourceTrafficMirrorFilterRuleRead(d, meta)
 }
 
+// createTrafficMirrorFilterRuleWithNextNumber creates the rule with the number
+// following the highest rule number already used in the filter's direction.
+// If another rule takes that number first, a new number is assigned and the
+// creation is retried.
+func createTrafficMirrorFilterRuleWithNextNumber(conn *ec2.EC2, input *ec2.CreateTrafficMirrorFilterRuleInput) (*ec2.CreateTrafficMirrorFilterRuleOutput, error) {
+	filterID := aws.StringValue(input.TrafficMirrorFilterId)
+	direction := aws.StringValue(input.TrafficDirection)
+
+	// Rule numbers are assigned from the rules that already exist, so creation
+	// is serialized per filter and direction.
+	mutexKey := fmt.Sprintf("ec2-traffic-mirror-filter-rule-%s-%s", filterID, direction)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(trafficMirrorFilterRuleNumberConflictTimeout, func() (interface{}, error) {
+		ruleNumber, err := nextTrafficMirrorFilterRuleNumber(conn, filterID, direction)
+
+		if err != nil {
+			return nil, err
+		}
+
+		input.RuleNumber = aws.Int64(int64(ruleNumber))
+
+		return conn.CreateTrafficMirrorFilterRule(input)
+	}, ErrCodeTrafficMirrorFilterRuleAlreadyExists)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*ec2.CreateTrafficMirrorFilterRuleOutput), nil
+}
+
+// nextTrafficMirrorFilterRuleNumber returns the number following the highest rule
+// number already used in the filter's direction.
+func nextTrafficMirrorFilterRuleNumber(conn *ec2.EC2, filterID, direction string) (int, error) {
+	output, err := conn.DescribeTrafficMirrorFilters(&ec2.DescribeTrafficMirrorFiltersInput{
+		TrafficMirrorFilterIds: aws.StringSlice([]string{filterID}),
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s): %w", filterID, err)
+	}
+
+	if output == nil || len(output.TrafficMirrorFilters) == 0 || output.TrafficMirrorFilters[0] == nil {
+		return 0, fmt.Errorf("EC2 Traffic Mirror Filter (%s) not found", filterID)
+	}
+
+	rules := output.TrafficMirrorFilters[0].IngressFilterRules
+	if direction == ec2.TrafficDirectionEgress {
+		rules = output.TrafficMirrorFilters[0].EgressFilterRules
+	}
+
+	var maxRuleNumber int
+	for _, rule := range rules {
+		if n := int(aws.Int64Value(rule.RuleNumber)); n > maxRuleNumber {
+			maxRuleNumber = n
+		}
+	}
+
+	if maxRuleNumber >= trafficMirrorFilterRuleNumberMax {
+		return 0, fmt.Errorf("no %s rule_number above %d is available to assign", direction, maxRuleNumber)
+	}
+
+	return maxRuleNumber + 1, nil
+}
+
 func resourceTrafficMirrorFilterRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	ruleId := d.Id()
@@ -188,6 +295,12 @@ func resourceTrafficMirrorFilterRuleRead(d *schema.ResourceData, meta interface{
 	d.Set("description", rule.Description)
 	d.Set("protocol", rule.Protocol)
 
+	// protocol_name is only read back when it is used, so that configurations
+	// setting protocol by number do not see a diff.
+	if _, ok := d.GetOk("protocol_name"); ok {
+		d.Set("protocol_name", trafficMirrorFilterRuleProtocolName(aws.Int64Value(rule.Protocol)))
+	}
+
 	if err := d.Set("destination_port_range", buildTrafficMirrorFilterRulePortRangeSchema(rule.DestinationPortRange)); err != nil {
 		return fmt.Errorf("error setting destination_port_range: %s", err)
 	}
@@ -243,13 +356,14 @@ func resourceTrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interfac
 		TrafficMirrorFilterRuleId: &ruleId,
 	}
 
+	protocol := trafficMirrorFilterRuleProtocol(d)
+
 	var removeFields []*string
-	if d.HasChange("protocol") {
-		n := d.Get("protocol")
-		if n == "0" {
+	if d.HasChanges("protocol", "protocol_name") {
+		if protocol <= 0 {
 			removeFields = append(removeFields, aws.String(ec2.TrafficMirrorFilterRuleFieldProtocol))
 		} else {
-			input.Protocol = aws.Int64(int64(d.Get("protocol").(int)))
+			input.Protocol = aws.Int64(int64(protocol))
 		}
 	}
 
@@ -277,7 +391,9 @@ func resourceTrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interfac
 			removeFields = append(removeFields, aws.String(ec2.TrafficMirrorFilterRuleFieldDestinationPortRange))
 		} else {
 			//Modify request that adds port range seems to fail if protocol is not set in the request
-			input.Protocol = aws.Int64(int64(d.Get("protocol").(int)))
+			if protocol > 0 {
+				input.Protocol = aws.Int64(int64(protocol))
+			}
 			input.DestinationPortRange = buildTrafficMirrorPortRangeRequest(n)
 		}
 	}
@@ -289,7 +405,9 @@ func resourceTrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interfac
 			removeFields = append(removeFields, aws.String(ec2.TrafficMirrorFilterRuleFieldSourcePortRange))
 		} else {
 			//Modify request that adds port range seems to fail if protocol is not set in the request
-			input.Protocol = aws.Int64(int64(d.Get("protocol").(int)))
+			if protocol > 0 {
+				input.Protocol = aws.Int64(int64(protocol))
+			}
 			input.SourcePortRange = buildTrafficMirrorPortRangeRequest(n)
 		}
 	}
@@ -299,7 +417,7 @@ func resourceTrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	if d.HasChange("rule_number") {
-		input.RuleNumber = aws.Int64(int64(d.Get("rule_action").(int)))
+		input.RuleNumber = aws.Int64(int64(d.Get("rule_number").(int)))
 	}
 
 	if d.HasChange("traffic_direction") {
@@ -318,6 +436,66 @@ func resourceTrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interfac
 	return resourceTrafficMirrorFilterRuleRead(d, meta)
 }
 
+// trafficMirrorFilterRuleProtocolNameAll matches traffic of any protocol.
+// The API represents it by leaving the rule's protocol unset.
+const trafficMirrorFilterRuleProtocolNameAll = "all"
+
+// trafficMirrorFilterRuleProtocolNames maps the protocol names accepted by
+// protocol_name to their IANA protocol numbers.
+var trafficMirrorFilterRuleProtocolNames = map[string]int{
+	trafficMirrorFilterRuleProtocolNameAll: -1,
+	"icmp":                                 1,
+	"tcp":                                  6,
+	"udp":                                  17,
+}
+
+func trafficMirrorFilterRuleProtocolNameValues() []string {
+	names := make([]string, 0, len(trafficMirrorFilterRuleProtocolNames))
+
+	for name := range trafficMirrorFilterRuleProtocolNames {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// trafficMirrorFilterRuleProtocol returns the IANA protocol number set by either
+// protocol or protocol_name. A number of zero or less means any protocol.
+func trafficMirrorFilterRuleProtocol(d *schema.ResourceData) int {
+	if v, ok := d.GetOk("protocol_name"); ok {
+		return trafficMirrorFilterRuleProtocolNames[v.(string)]
+	}
+
+	return d.Get("protocol").(int)
+}
+
+// trafficMirrorFilterRuleProtocolName converts an IANA protocol number read
+// from the API to its name, or to the number itself when it has no name.
+// A zero protocol means the rule's protocol is unset, which matches any protocol.
+func trafficMirrorFilterRuleProtocolName(v int64) string {
+	if v == 0 {
+		return trafficMirrorFilterRuleProtocolNameAll
+	}
+
+	for name, i := range trafficMirrorFilterRuleProtocolNames {
+		if int64(i) == v {
+			return name
+		}
+	}
+
+	return strconv.FormatInt(v, 10)
+}
+
+// suppressTrafficMirrorFilterRuleProtocolSetByName suppresses the diff of the
+// numeric protocol read back from the API when protocol_name sets it instead.
+func suppressTrafficMirrorFilterRuleProtocolSetByName(k, old, new string, d *schema.ResourceData) bool {
+	_, ok := d.GetOk("protocol_name")
+
+	return ok && new == "0"
+}
+
 func resourceTrafficMirrorFilterRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
@@ -366,13 +544,48 @@ func buildTrafficMirrorFilterRulePortRangeSchema(portRange *ec2.TrafficMirrorPor
 }
 
 func resourceTrafficMirrorFilterRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.SplitN(d.Id(), ":", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return nil, fmt.Errorf("unexpected format (%q), expected <filter-id>:<rule-id>", d.Id())
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	filterID, ruleID, err := trafficMirrorFilterRuleParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
 	}
 
-	d.Set("traffic_mirror_filter_id", parts[0])
-	d.SetId(parts[1])
+	output, err := conn.DescribeTrafficMirrorFilters(&ec2.DescribeTrafficMirrorFiltersInput{
+		TrafficMirrorFilterIds: aws.StringSlice([]string{filterID}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, "InvalidTrafficMirrorFilterId.NotFound") {
+		return nil, fmt.Errorf("EC2 Traffic Mirror Filter (%s) not found", filterID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s): %w", filterID, err)
+	}
+
+	if output == nil || len(output.TrafficMirrorFilters) == 0 {
+		return nil, fmt.Errorf("EC2 Traffic Mirror Filter (%s) not found", filterID)
+	}
+
+	if rule := findEc2TrafficMirrorFilterRule(ruleID, output.TrafficMirrorFilters); rule == nil {
+		return nil, fmt.Errorf("EC2 Traffic Mirror Filter Rule (%s) not found in Traffic Mirror Filter (%s)", ruleID, filterID)
+	}
+
+	d.Set("traffic_mirror_filter_id", filterID)
+	d.SetId(ruleID)
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// trafficMirrorFilterRuleParseImportID parses an import ID of the form
+// <filter-id>:<rule-id>, e.g. tmf-0123456789abcdef0:tmfr-0123456789abcdef0.
+func trafficMirrorFilterRuleParseImportID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || !trafficMirrorFilterIDRegexp.MatchString(parts[0]) || !trafficMirrorFilterRuleIDRegexp.MatchString(parts[1]) {
+		return "", "", fmt.Errorf("unexpected format (%q), expected <filter-id>:<rule-id>, e.g. tmf-0123456789abcdef0:tmfr-0123456789abcdef0", id)
+	}
+
+	return parts[0], parts[1], nil
+}
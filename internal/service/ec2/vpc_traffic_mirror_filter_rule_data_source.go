@@ -0,0 +1,183 @@
+package ec2
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceTrafficMirrorFilterRule() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTrafficMirrorFilterRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"egress_filter_rules":  dataSourceTrafficMirrorFilterRulesSchema(),
+			"ingress_filter_rules": dataSourceTrafficMirrorFilterRulesSchema(),
+			"traffic_mirror_filter_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceTrafficMirrorFilterRulesSchema() *schema.Schema {
+	portRangeSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"to_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"destination_cidr_block": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"destination_port_range": portRangeSchema,
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"protocol": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"protocol_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rule_action": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rule_number": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"source_cidr_block": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"source_port_range": portRangeSchema,
+			},
+		},
+	}
+}
+
+func dataSourceTrafficMirrorFilterRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	filterID := d.Get("traffic_mirror_filter_id").(string)
+	input := &ec2.DescribeTrafficMirrorFiltersInput{
+		TrafficMirrorFilterIds: aws.StringSlice([]string{filterID}),
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Traffic Mirror Filter Rules: %s", input)
+	output, err := conn.DescribeTrafficMirrorFilters(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s) rules: %w", filterID, err)
+	}
+
+	if output == nil || len(output.TrafficMirrorFilters) == 0 || output.TrafficMirrorFilters[0] == nil {
+		return errors.New("error reading EC2 Traffic Mirror Filter rules: no results found")
+	}
+
+	trafficMirrorFilter := output.TrafficMirrorFilters[0]
+
+	d.SetId(aws.StringValue(trafficMirrorFilter.TrafficMirrorFilterId))
+
+	egressFilterRules, err := flattenTrafficMirrorFilterRules(meta.(*conns.AWSClient), trafficMirrorFilter.EgressFilterRules)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s) rules: %w", filterID, err)
+	}
+
+	if err := d.Set("egress_filter_rules", egressFilterRules); err != nil {
+		return fmt.Errorf("error setting egress_filter_rules: %w", err)
+	}
+
+	ingressFilterRules, err := flattenTrafficMirrorFilterRules(meta.(*conns.AWSClient), trafficMirrorFilter.IngressFilterRules)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s) rules: %w", filterID, err)
+	}
+
+	if err := d.Set("ingress_filter_rules", ingressFilterRules); err != nil {
+		return fmt.Errorf("error setting ingress_filter_rules: %w", err)
+	}
+
+	return nil
+}
+
+// flattenTrafficMirrorFilterRules returns the rules ordered by rule number,
+// the order in which they are evaluated.
+func flattenTrafficMirrorFilterRules(client *conns.AWSClient, apiObjects []*ec2.TrafficMirrorFilterRule) ([]interface{}, error) {
+	var rules []*ec2.TrafficMirrorFilterRule
+
+	for _, apiObject := range apiObjects {
+		if apiObject != nil {
+			rules = append(rules, apiObject)
+		}
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return aws.Int64Value(rules[i].RuleNumber) < aws.Int64Value(rules[j].RuleNumber)
+	})
+
+	tfList := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		ruleID := aws.StringValue(rule.TrafficMirrorFilterRuleId)
+		arn, err := client.RegionalARN(ec2.ServiceName, fmt.Sprintf("traffic-mirror-filter-rule/%s", ruleID))
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                    arn,
+			"description":            aws.StringValue(rule.Description),
+			"destination_cidr_block": aws.StringValue(rule.DestinationCidrBlock),
+			"destination_port_range": buildTrafficMirrorFilterRulePortRangeSchema(rule.DestinationPortRange),
+			"id":                     ruleID,
+			"protocol":               int(aws.Int64Value(rule.Protocol)),
+			"protocol_name":          trafficMirrorFilterRuleProtocolName(aws.Int64Value(rule.Protocol)),
+			"rule_action":            aws.StringValue(rule.RuleAction),
+			"rule_number":            int(aws.Int64Value(rule.RuleNumber)),
+			"source_cidr_block":      aws.StringValue(rule.SourceCidrBlock),
+			"source_port_range":      buildTrafficMirrorFilterRulePortRangeSchema(rule.SourcePortRange),
+		})
+	}
+
+	return tfList, nil
+}
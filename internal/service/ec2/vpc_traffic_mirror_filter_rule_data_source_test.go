@@ -0,0 +1,119 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2TrafficMirrorFilterRuleDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_traffic_mirror_filter_rule.test"
+	filterResourceName := "aws_ec2_traffic_mirror_filter.test"
+	ingress1ResourceName := "aws_ec2_traffic_mirror_filter_rule.ingress1"
+	ingress2ResourceName := "aws_ec2_traffic_mirror_filter_rule.ingress2"
+	egressResourceName := "aws_ec2_traffic_mirror_filter_rule.egress"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilterRule(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficMirrorFilterRuleDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", filterResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ingress_filter_rules.0.id", ingress2ResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ingress_filter_rules.0.arn", ingress2ResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.0.rule_number", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.0.rule_action", "reject"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.0.source_cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.0.destination_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ingress_filter_rules.1.id", ingress1ResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.1.rule_number", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.1.protocol", "6"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.1.protocol_name", "tcp"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.1.source_port_range.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.1.source_port_range.0.from_port", "1024"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.1.source_port_range.0.to_port", "65535"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.1.destination_port_range.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.1.destination_port_range.0.from_port", "443"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress_filter_rules.1.destination_port_range.0.to_port", "443"),
+					resource.TestCheckResourceAttr(dataSourceName, "egress_filter_rules.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "egress_filter_rules.0.id", egressResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "egress_filter_rules.0.rule_number", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "egress_filter_rules.0.rule_action", "accept"),
+					resource.TestCheckResourceAttr(dataSourceName, "egress_filter_rules.0.description", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrafficMirrorFilterRuleDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "ingress1" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "10.0.0.0/8"
+  rule_action              = "accept"
+  rule_number              = 2
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+  protocol                 = 6
+
+  source_port_range {
+    from_port = 1024
+    to_port   = 65535
+  }
+
+  destination_port_range {
+    from_port = 443
+    to_port   = 443
+  }
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "ingress2" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "0.0.0.0/0"
+  rule_action              = "reject"
+  rule_number              = 1
+  source_cidr_block        = "10.0.0.0/8"
+  traffic_direction        = "ingress"
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "egress" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  description              = %[1]q
+  destination_cidr_block   = "0.0.0.0/0"
+  rule_action              = "accept"
+  rule_number              = 1
+  source_cidr_block        = "10.0.0.0/8"
+  traffic_direction        = "egress"
+}
+
+data "aws_ec2_traffic_mirror_filter_rule" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+
+  depends_on = [
+    aws_ec2_traffic_mirror_filter_rule.ingress1,
+    aws_ec2_traffic_mirror_filter_rule.ingress2,
+    aws_ec2_traffic_mirror_filter_rule.egress,
+  ]
+}
+`, rName)
+}
@@ -0,0 +1,65 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTrafficMirrorFilterRuleProtocolName(t *testing.T) {
+	testCases := []struct {
+		Value    int64
+		Expected string
+	}{
+		{Value: 0, Expected: "all"},
+		{Value: 1, Expected: "icmp"},
+		{Value: 6, Expected: "tcp"},
+		{Value: 17, Expected: "udp"},
+		{Value: 47, Expected: "47"},
+	}
+
+	for _, testCase := range testCases {
+		if got := trafficMirrorFilterRuleProtocolName(testCase.Value); got != testCase.Expected {
+			t.Errorf("%d: got %q, expected %q", testCase.Value, got, testCase.Expected)
+		}
+	}
+}
+
+func TestTrafficMirrorFilterRuleProtocol(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Raw      map[string]interface{}
+		Expected int
+	}{
+		{
+			Name:     "unset",
+			Raw:      map[string]interface{}{},
+			Expected: 0,
+		},
+		{
+			Name:     "number",
+			Raw:      map[string]interface{}{"protocol": 47},
+			Expected: 47,
+		},
+		{
+			Name:     "name",
+			Raw:      map[string]interface{}{"protocol_name": "udp"},
+			Expected: 17,
+		},
+		{
+			Name:     "all",
+			Raw:      map[string]interface{}{"protocol_name": "all"},
+			Expected: -1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceTrafficMirrorFilterRule().Schema, testCase.Raw)
+
+			if got := trafficMirrorFilterRuleProtocol(d); got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -105,6 +107,387 @@ func TestAccEC2TrafficMirrorFilterRule_basic(t *testing.T) {
 	})
 }
 
+func TestAccEC2TrafficMirrorFilterRule_protocolName(t *testing.T) {
+	resourceName := "aws_ec2_traffic_mirror_filter_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilterRule(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfigProtocolName("tcp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "6"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_name", "tcp"),
+				),
+			},
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfigProtocolName("all"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "0"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_name", "all"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTrafficMirrorFilterRuleImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"protocol_name"},
+			},
+		},
+	})
+}
+
+func TestResourceTrafficMirrorFilterRuleCreate_ruleNumber(t *testing.T) {
+	var rules []*ec2.TrafficMirrorFilterRule
+	var describes int
+	var stale bool
+	conn := newMockEC2Conn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *ec2.DescribeTrafficMirrorFiltersOutput:
+			describes++
+
+			described := rules
+			// Miss the newest rule once, as if it were created concurrently.
+			if stale {
+				stale = false
+				described = rules[:len(rules)-1]
+			}
+
+			data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{
+				{
+					IngressFilterRules:    described,
+					TrafficMirrorFilterId: aws.String("tmf-12345678"),
+				},
+			}
+		case *ec2.CreateTrafficMirrorFilterRuleOutput:
+			input := r.Params.(*ec2.CreateTrafficMirrorFilterRuleInput)
+
+			for _, rule := range rules {
+				if aws.Int64Value(rule.RuleNumber) == aws.Int64Value(input.RuleNumber) {
+					r.Error = awserr.New(tfec2.ErrCodeTrafficMirrorFilterRuleAlreadyExists, "The rule number is already in use", nil)
+					return
+				}
+			}
+
+			rule := &ec2.TrafficMirrorFilterRule{
+				DestinationCidrBlock:      input.DestinationCidrBlock,
+				RuleAction:                input.RuleAction,
+				RuleNumber:                input.RuleNumber,
+				SourceCidrBlock:           input.SourceCidrBlock,
+				TrafficDirection:          input.TrafficDirection,
+				TrafficMirrorFilterId:     input.TrafficMirrorFilterId,
+				TrafficMirrorFilterRuleId: aws.String(fmt.Sprintf("tmfr-%d", len(rules)+1)),
+			}
+			rules = append(rules, rule)
+			data.TrafficMirrorFilterRule = rule
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{EC2Conn: conn}
+
+	testCases := []struct {
+		Name              string
+		RuleNumber        int
+		Stale             bool
+		Expected          int
+		ExpectedDescribes int
+		ExpectedError     *regexp.Regexp
+	}{
+		{
+			Name:              "first auto",
+			Expected:          1,
+			ExpectedDescribes: 2,
+		},
+		{
+			Name:              "second auto",
+			Expected:          2,
+			ExpectedDescribes: 2,
+		},
+		{
+			Name:              "explicit already assigned",
+			RuleNumber:        2,
+			ExpectedDescribes: 0,
+			ExpectedError:     regexp.MustCompile(tfec2.ErrCodeTrafficMirrorFilterRuleAlreadyExists),
+		},
+		{
+			Name:              "explicit",
+			RuleNumber:        10,
+			Expected:          10,
+			ExpectedDescribes: 1,
+		},
+		{
+			Name:              "auto after explicit",
+			Expected:          11,
+			ExpectedDescribes: 2,
+		},
+		{
+			Name:              "auto number taken concurrently",
+			Stale:             true,
+			Expected:          12,
+			ExpectedDescribes: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			describes = 0
+			stale = testCase.Stale
+
+			r := tfec2.ResourceTrafficMirrorFilterRule()
+			d := r.TestResourceData()
+			d.Set("destination_cidr_block", "10.0.0.0/8")
+			d.Set("rule_action", ec2.TrafficMirrorRuleActionAccept)
+			d.Set("source_cidr_block", "0.0.0.0/0")
+			d.Set("traffic_direction", ec2.TrafficDirectionIngress)
+			d.Set("traffic_mirror_filter_id", "tmf-12345678")
+			if testCase.RuleNumber != 0 {
+				d.Set("rule_number", testCase.RuleNumber)
+			}
+
+			err := r.Create(d, meta)
+
+			if describes != testCase.ExpectedDescribes {
+				t.Errorf("got %d filter descriptions, expected %d", describes, testCase.ExpectedDescribes)
+			}
+
+			if testCase.ExpectedError != nil {
+				if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Get("rule_number").(int); got != testCase.Expected {
+				t.Errorf("got rule_number %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAccEC2TrafficMirrorFilterRule_import(t *testing.T) {
+	resourceName := "aws_ec2_traffic_mirror_filter_rule.test"
+	dstCidr := "10.0.0.0/8"
+	srcCidr := "0.0.0.0/0"
+	ruleNum := 1
+	action := "accept"
+	direction := "egress"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilterRule(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfig(dstCidr, srcCidr, action, direction, ruleNum),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRuleExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTrafficMirrorFilterRuleImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "tmfr-0123456789abcdef0",
+				ExpectError:   regexp.MustCompile(`unexpected format`),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("%s:tmfr-0000000000000000f", rs.Primary.Attributes["traffic_mirror_filter_id"]), nil
+				},
+				ExpectError: regexp.MustCompile(`EC2 Traffic Mirror Filter Rule \(tmfr-0000000000000000f\) not found`),
+			},
+		},
+	})
+}
+
+func TestResourceTrafficMirrorFilterRuleImport(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ID            string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name: "valid",
+			ID:   "tmf-0123456789abcdef0:tmfr-0123456789abcdef0",
+		},
+		{
+			Name:          "rule ID only",
+			ID:            "tmfr-0123456789abcdef0",
+			ExpectedError: regexp.MustCompile(`unexpected format`),
+		},
+		{
+			Name:          "swapped",
+			ID:            "tmfr-0123456789abcdef0:tmf-0123456789abcdef0",
+			ExpectedError: regexp.MustCompile(`unexpected format`),
+		},
+		{
+			Name:          "filter not found",
+			ID:            "tmf-0000000000000000f:tmfr-0123456789abcdef0",
+			ExpectedError: regexp.MustCompile(`EC2 Traffic Mirror Filter \(tmf-0000000000000000f\) not found`),
+		},
+		{
+			Name:          "rule not in filter",
+			ID:            "tmf-0123456789abcdef0:tmfr-0000000000000000f",
+			ExpectedError: regexp.MustCompile(`EC2 Traffic Mirror Filter Rule \(tmfr-0000000000000000f\) not found in Traffic Mirror Filter \(tmf-0123456789abcdef0\)`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := newMockEC2Conn(t, func(r *request.Request) {
+				switch data := r.Data.(type) {
+				case *ec2.DescribeTrafficMirrorFiltersOutput:
+					if aws.StringValue(r.Params.(*ec2.DescribeTrafficMirrorFiltersInput).TrafficMirrorFilterIds[0]) != "tmf-0123456789abcdef0" {
+						r.Error = awserr.New("InvalidTrafficMirrorFilterId.NotFound", "The traffic mirror filter does not exist.", nil)
+						return
+					}
+
+					data.TrafficMirrorFilters = []*ec2.TrafficMirrorFilter{
+						{
+							EgressFilterRules: []*ec2.TrafficMirrorFilterRule{
+								{
+									TrafficMirrorFilterId:     aws.String("tmf-0123456789abcdef0"),
+									TrafficMirrorFilterRuleId: aws.String("tmfr-0123456789abcdef0"),
+								},
+							},
+							TrafficMirrorFilterId: aws.String("tmf-0123456789abcdef0"),
+						},
+					}
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+
+			r := tfec2.ResourceTrafficMirrorFilterRule()
+			d := r.TestResourceData()
+			d.SetId(testCase.ID)
+
+			got, err := r.Importer.State(d, &conns.AWSClient{EC2Conn: conn})
+
+			if testCase.ExpectedError != nil {
+				if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("got %d resources, expected 1", len(got))
+			}
+
+			if got, want := got[0].Id(), "tmfr-0123456789abcdef0"; got != want {
+				t.Errorf("got ID %s, expected %s", got, want)
+			}
+
+			if got, want := got[0].Get("traffic_mirror_filter_id").(string), "tmf-0123456789abcdef0"; got != want {
+				t.Errorf("got traffic_mirror_filter_id %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAccEC2TrafficMirrorFilterRule_autoRuleNumber(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilterRule(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfigAutoRuleNumber(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_ec2_traffic_mirror_filter_rule.first", "rule_number", "1"),
+					resource.TestCheckResourceAttr("aws_ec2_traffic_mirror_filter_rule.second", "rule_number", "2"),
+					resource.TestCheckResourceAttr("aws_ec2_traffic_mirror_filter_rule.egress", "rule_number", "1"),
+				),
+			},
+			// Assigned numbers are persisted, so an unchanged configuration has no diff.
+			{
+				Config:   testAccEc2TrafficMirrorFilterRuleConfigAutoRuleNumber(false),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfigAutoRuleNumber(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_ec2_traffic_mirror_filter_rule.first", "rule_number", "1"),
+					resource.TestCheckResourceAttr("aws_ec2_traffic_mirror_filter_rule.second", "rule_number", "2"),
+					resource.TestCheckResourceAttr("aws_ec2_traffic_mirror_filter_rule.third[0]", "rule_number", "3"),
+					resource.TestCheckResourceAttr("aws_ec2_traffic_mirror_filter_rule.egress", "rule_number", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2TrafficMirrorFilterRule_reorderBlocks(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilterRule(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfigReorderBlocks(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRuleExists("aws_ec2_traffic_mirror_filter_rule.accept"),
+					testAccCheckTrafficMirrorFilterRuleExists("aws_ec2_traffic_mirror_filter_rule.reject"),
+					resource.TestCheckResourceAttr("aws_ec2_traffic_mirror_filter_rule.accept", "rule_number", "10"),
+					resource.TestCheckResourceAttr("aws_ec2_traffic_mirror_filter_rule.reject", "rule_number", "20"),
+				),
+			},
+			// Each rule is its own resource keyed by ID, so reordering the
+			// blocks in configuration plans no changes and makes no API calls.
+			{
+				Config:   testAccEc2TrafficMirrorFilterRuleConfigReorderBlocks(true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2TrafficMirrorFilterRule_disappears(t *testing.T) {
 	resourceName := "aws_ec2_traffic_mirror_filter_rule.test"
 	dstCidr := "10.0.0.0/8"
@@ -199,6 +582,82 @@ resource "aws_ec2_traffic_mirror_filter_rule" "test" {
 `, dstCidr, action, num, srcCidr, dir)
 }
 
+func testAccEc2TrafficMirrorFilterRuleConfigAutoRuleNumber(third bool) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "first" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "10.0.0.0/8"
+  rule_action              = "accept"
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "second" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "172.16.0.0/12"
+  rule_action              = "reject"
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+
+  depends_on = [aws_ec2_traffic_mirror_filter_rule.first]
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "third" {
+  count = %[1]t ? 1 : 0
+
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "192.168.0.0/16"
+  rule_action              = "accept"
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+
+  depends_on = [aws_ec2_traffic_mirror_filter_rule.second]
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "egress" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "0.0.0.0/0"
+  rule_action              = "accept"
+  source_cidr_block        = "10.0.0.0/8"
+  traffic_direction        = "egress"
+}
+`, third)
+}
+
+func testAccEc2TrafficMirrorFilterRuleConfigReorderBlocks(reversed bool) string {
+	rules := []string{`
+resource "aws_ec2_traffic_mirror_filter_rule" "accept" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "10.0.0.0/8"
+  rule_action              = "accept"
+  rule_number              = 10
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+}
+`, `
+resource "aws_ec2_traffic_mirror_filter_rule" "reject" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "172.16.0.0/12"
+  rule_action              = "reject"
+  rule_number              = 20
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+}
+`}
+
+	if reversed {
+		rules[0], rules[1] = rules[1], rules[0]
+	}
+
+	return acctest.ConfigCompose(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+}
+`, rules[0], rules[1])
+}
+
 func testAccEc2TrafficMirrorFilterRuleConfigFull(dstCidr, srcCidr, action, dir, description string, ruleNum, srcPortFrom, srcPortTo, dstPortFrom, dstPortTo, protocol int) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_traffic_mirror_filter" "test" {}
@@ -224,6 +683,22 @@ resource "aws_ec2_traffic_mirror_filter_rule" "test" {
 `, dstCidr, action, ruleNum, srcCidr, dir, description, protocol, srcPortFrom, srcPortTo, dstPortFrom, dstPortTo)
 }
 
+func testAccEc2TrafficMirrorFilterRuleConfigProtocolName(protocolName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "10.0.0.0/8"
+  rule_action              = "accept"
+  rule_number              = 1
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+  protocol_name            = %[1]q
+}
+`, protocolName)
+}
+
 func testAccPreCheckTrafficMirrorFilterRule(t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
 
@@ -3,16 +3,20 @@ package ec2_test
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestAccEC2TrafficMirrorFilter_basic(t *testing.T) {
@@ -35,8 +39,11 @@ func TestAccEC2TrafficMirrorFilter_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`traffic-mirror-filter/tmf-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "client_token"),
 					resource.TestCheckResourceAttr(resourceName, "description", description),
 					resource.TestCheckResourceAttr(resourceName, "network_services.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "strict_network_services", "true"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
@@ -58,14 +65,163 @@ func TestAccEC2TrafficMirrorFilter_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_token"},
+			},
+		},
+	})
+}
+
+func TestAccEC2TrafficMirrorFilter_importWithRules(t *testing.T) {
+	var v ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficMirrorFilterConfigWithRules("test filter"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+				),
+			},
+			// Rules are managed by aws_ec2_traffic_mirror_filter_rule, so importing
+			// a filter that has rules must not produce a difference on the filter.
+			// rule_count is only refreshed after the rules are created.
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_token", "rule_count"},
+			},
+			{
+				ResourceName:      "aws_ec2_traffic_mirror_filter_rule.ingress",
 				ImportState:       true,
+				ImportStateIdFunc: testAccTrafficMirrorFilterRuleImportStateIdFunc("aws_ec2_traffic_mirror_filter_rule.ingress"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "aws_ec2_traffic_mirror_filter_rule.egress",
+				ImportState:       true,
+				ImportStateIdFunc: testAccTrafficMirrorFilterRuleImportStateIdFunc("aws_ec2_traffic_mirror_filter_rule.egress"),
 				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
+func TestAccEC2TrafficMirrorFilter_ruleCount(t *testing.T) {
+	var v ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficMirrorFilterConfigWithRules("test filter"),
+			},
+			// The rules are created after the filter, so refresh to pick them up.
+			{
+				Config: testAccTrafficMirrorFilterConfigWithRules("test filter"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_count", "2"),
+					testAccCheckTrafficMirrorFilterRuleCount(resourceName, &v),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2TrafficMirrorFilter_sessionCount(t *testing.T) {
+	var v ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.filter"
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+	session := sdkacctest.RandIntRange(1, 32766)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+			testAccPreCheckTrafficMirrorSession(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficMirrorSessionConfigBase(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "session_count", "0"),
+				),
+			},
+			{
+				Config: testAccTrafficMirrorSessionConfig(rName, session),
+			},
+			// The session is created after the filter, so refresh to pick it up.
+			{
+				Config: testAccTrafficMirrorSessionConfig(rName, session),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "session_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2TrafficMirrorFilter_networkServicesKeepTags(t *testing.T) {
+	var v ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficMirrorFilterConfigNetworkServicesTags1("key1", "value1", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "network_services.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccTrafficMirrorFilterConfigNetworkServicesTags1("key1", "value1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					testAccCheckTrafficMirrorFilterTags(&v, map[string]string{"key1": "value1"}),
+					resource.TestCheckResourceAttr(resourceName, "network_services.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2TrafficMirrorFilter_tags(t *testing.T) {
 	var v ec2.TrafficMirrorFilter
 	resourceName := "aws_ec2_traffic_mirror_filter.test"
@@ -88,9 +244,10 @@ func TestAccEC2TrafficMirrorFilter_tags(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_token"},
 			},
 			{
 				Config: testAccTrafficMirrorFilterConfigTags2("key1", "value1updated", "key2", "value2"),
@@ -113,6 +270,259 @@ func TestAccEC2TrafficMirrorFilter_tags(t *testing.T) {
 	})
 }
 
+func TestAccEC2TrafficMirrorFilter_DefaultTags_providerOnly(t *testing.T) {
+	var providers []*schema.Provider
+	var v ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccTrafficMirrorFilterConfigWithoutDNS("test filter"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					testAccCheckTrafficMirrorFilterTags(&v, map[string]string{"providerkey1": "providervalue1"}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2TrafficMirrorFilter_DefaultTags_overlapping(t *testing.T) {
+	var providers []*schema.Provider
+	var v ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("overlapkey1", "overlapvalue1"),
+					testAccTrafficMirrorFilterConfigTags2("overlapkey1", "overlapvalue1", "resourcekey1", "resourcevalue1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					testAccCheckTrafficMirrorFilterTags(&v, map[string]string{"overlapkey1": "overlapvalue1", "resourcekey1": "resourcevalue1"}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.overlapkey1", "overlapvalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey1", "overlapvalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("overlapkey1", "overlapvalue1"),
+					testAccTrafficMirrorFilterConfigTags2("overlapkey1", "overlapvalue1", "resourcekey1", "resourcevalue1"),
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2TrafficMirrorFilter_tagsWithRules(t *testing.T) {
+	var v ec2.TrafficMirrorFilter
+	var ingressRuleID, egressRuleID string
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+	ingressResourceName := "aws_ec2_traffic_mirror_filter_rule.ingress"
+	egressResourceName := "aws_ec2_traffic_mirror_filter_rule.egress"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficMirrorFilterConfigTags1WithRules("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					testAccCheckTrafficMirrorFilterRuleID(ingressResourceName, &ingressRuleID),
+					testAccCheckTrafficMirrorFilterRuleID(egressResourceName, &egressRuleID),
+				),
+			},
+			{
+				Config: testAccTrafficMirrorFilterConfigTags2WithRules("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					resource.TestCheckResourceAttrPtr(ingressResourceName, "id", &ingressRuleID),
+					resource.TestCheckResourceAttrPtr(egressResourceName, "id", &egressRuleID),
+					resource.TestCheckResourceAttr(ingressResourceName, "rule_number", "1"),
+					resource.TestCheckResourceAttr(egressResourceName, "rule_number", "1"),
+				),
+			},
+			// Tag-only changes must not leave the rules with a pending difference.
+			{
+				Config:   testAccTrafficMirrorFilterConfigTags2WithRules("key1", "value1updated", "key2", "value2"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2TrafficMirrorFilter_dryRun(t *testing.T) {
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+	description := fmt.Sprintf("dry run %s", sdkacctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficMirrorFilterConfigDryRun(description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^tmf-dryrun-`)),
+					resource.TestCheckResourceAttr(resourceName, "dry_run", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule_count", "0"),
+					testAccCheckTrafficMirrorFilterNotCreated(description),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2TrafficMirrorFilter_ignoreNetworkServices(t *testing.T) {
+	var v ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+	description := "test filter"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficMirrorFilterConfigIgnoreNetworkServices(description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ignore_network_services.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_services.#", "0"),
+					// Simulate another automation enabling amazon-dns.
+					testAccCheckTrafficMirrorFilterAddNetworkService(&v, "amazon-dns"),
+				),
+			},
+			{
+				Config:   testAccTrafficMirrorFilterConfigIgnoreNetworkServices(description),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2TrafficMirrorFilter_networkServiceRemovedExternally(t *testing.T) {
+	var v ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+	description := "test filter"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficMirrorFilterConfig(description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "network_services.#", "1"),
+					testAccCheckTrafficMirrorFilterRemoveNetworkService(&v, "amazon-dns"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTrafficMirrorFilterConfig(description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "network_services.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "network_services.*", "amazon-dns"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2TrafficMirrorFilter_strictNetworkServices(t *testing.T) {
+	var v ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+	description := "test filter"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTrafficMirrorFilterConfigStrictNetworkServices(description, "amazon-unknown-service", true),
+				ExpectError: regexp.MustCompile(`set strict_network_services to false`),
+			},
+			{
+				Config: testAccTrafficMirrorFilterConfigStrictNetworkServices(description, "amazon-dns", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "network_services.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "strict_network_services", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_token", "strict_network_services"},
+			},
+			{
+				// Unknown services are rejected by EC2 instead.
+				Config:      testAccTrafficMirrorFilterConfigStrictNetworkServices(description, "amazon-unknown-service", false),
+				ExpectError: regexp.MustCompile(`error modifying EC2 Traffic Mirror Filter \(tmf-.+\) network services`),
+			},
+		},
+	})
+}
+
 func TestAccEC2TrafficMirrorFilter_disappears(t *testing.T) {
 	var v ec2.TrafficMirrorFilter
 	resourceName := "aws_ec2_traffic_mirror_filter.test"
@@ -170,6 +580,91 @@ func testAccCheckTrafficMirrorFilterExists(name string, traffic *ec2.TrafficMirr
 	}
 }
 
+// testAccCheckTrafficMirrorFilterTags verifies the tags the filter was created with, as returned by the API.
+func testAccCheckTrafficMirrorFilterAddNetworkService(traffic *ec2.TrafficMirrorFilter, service string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := conn.ModifyTrafficMirrorFilterNetworkServices(&ec2.ModifyTrafficMirrorFilterNetworkServicesInput{
+			TrafficMirrorFilterId: traffic.TrafficMirrorFilterId,
+			AddNetworkServices:    aws.StringSlice([]string{service}),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckTrafficMirrorFilterRemoveNetworkService(traffic *ec2.TrafficMirrorFilter, service string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := conn.ModifyTrafficMirrorFilterNetworkServices(&ec2.ModifyTrafficMirrorFilterNetworkServicesInput{
+			TrafficMirrorFilterId: traffic.TrafficMirrorFilterId,
+			RemoveNetworkServices: aws.StringSlice([]string{service}),
+		})
+
+		return err
+	}
+}
+
+// testAccCheckTrafficMirrorFilterNotCreated checks that no filter with the description exists.
+func testAccCheckTrafficMirrorFilterNotCreated(description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		out, err := conn.DescribeTrafficMirrorFilters(&ec2.DescribeTrafficMirrorFiltersInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("description"),
+					Values: aws.StringSlice([]string{description}),
+				},
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if n := len(out.TrafficMirrorFilters); n != 0 {
+			return fmt.Errorf("dry run created %d Traffic mirror filter(s) with description %q", n, description)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTrafficMirrorFilterTags(traffic *ec2.TrafficMirrorFilter, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := tfec2.KeyValueTags(traffic.Tags).IgnoreAWS(); !got.Equal(tftags.New(expected)) {
+			return fmt.Errorf("Traffic mirror filter %s tags: got %s, expected %s", aws.StringValue(traffic.TrafficMirrorFilterId), got.Map(), expected)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckTrafficMirrorFilterRuleID stores the ID of the named rule so later steps can assert it was not replaced.
+func testAccCheckTrafficMirrorFilterRuleCount(name string, traffic *ec2.TrafficMirrorFilter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		expected := len(traffic.IngressFilterRules) + len(traffic.EgressFilterRules)
+
+		return resource.TestCheckResourceAttr(name, "rule_count", strconv.Itoa(expected))(s)
+	}
+}
+
+func testAccCheckTrafficMirrorFilterRuleID(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		*id = rs.Primary.ID
+
+		return nil
+	}
+}
+
 func testAccTrafficMirrorFilterConfig(description string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_traffic_mirror_filter" "test" {
@@ -180,6 +675,36 @@ resource "aws_ec2_traffic_mirror_filter" "test" {
 `, description)
 }
 
+func testAccTrafficMirrorFilterConfigDryRun(description string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  description = %[1]q
+  dry_run     = true
+}
+`, description)
+}
+
+func testAccTrafficMirrorFilterConfigIgnoreNetworkServices(description string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  description = %[1]q
+
+  ignore_network_services = ["amazon-dns"]
+}
+`, description)
+}
+
+func testAccTrafficMirrorFilterConfigStrictNetworkServices(description, networkService string, strict bool) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  description = %[1]q
+
+  network_services        = [%[2]q]
+  strict_network_services = %[3]t
+}
+`, description, networkService, strict)
+}
+
 func testAccTrafficMirrorFilterConfigWithoutDNS(description string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_traffic_mirror_filter" "test" {
@@ -188,6 +713,86 @@ resource "aws_ec2_traffic_mirror_filter" "test" {
 `, description)
 }
 
+func testAccTrafficMirrorFilterConfigWithRules(description string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  description = %[1]q
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "ingress" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "10.0.0.0/8"
+  rule_action              = "accept"
+  rule_number              = 1
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+  protocol                 = 6
+
+  destination_port_range {
+    from_port = 443
+    to_port   = 443
+  }
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "egress" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "0.0.0.0/0"
+  rule_action              = "reject"
+  rule_number              = 1
+  source_cidr_block        = "10.0.0.0/8"
+  traffic_direction        = "egress"
+}
+`, description)
+}
+
+const testAccTrafficMirrorFilterRulesConfig = `
+resource "aws_ec2_traffic_mirror_filter_rule" "ingress" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "10.0.0.0/8"
+  rule_action              = "accept"
+  rule_number              = 1
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "egress" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "0.0.0.0/0"
+  rule_action              = "reject"
+  rule_number              = 1
+  source_cidr_block        = "10.0.0.0/8"
+  traffic_direction        = "egress"
+}
+`
+
+func testAccTrafficMirrorFilterConfigTags1WithRules(tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTrafficMirrorFilterConfigTags1(tagKey1, tagValue1), testAccTrafficMirrorFilterRulesConfig)
+}
+
+func testAccTrafficMirrorFilterConfigTags2WithRules(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTrafficMirrorFilterConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2), testAccTrafficMirrorFilterRulesConfig)
+}
+
+func testAccTrafficMirrorFilterConfigNetworkServicesTags1(tagKey1, tagValue1 string, dns bool) string {
+	var networkServices string
+
+	if dns {
+		networkServices = `["amazon-dns"]`
+	} else {
+		networkServices = `[]`
+	}
+
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  network_services = %[3]s
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1, networkServices)
+}
+
 func testAccTrafficMirrorFilterConfigTags1(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_traffic_mirror_filter" "test" {
@@ -231,6 +836,11 @@ func testAccCheckTrafficMirrorFilterDestroy(s *terraform.State) error {
 			continue
 		}
 
+		// Dry-run filters are never created.
+		if rs.Primary.Attributes["dry_run"] == "true" {
+			continue
+		}
+
 		out, err := conn.DescribeTrafficMirrorFilters(&ec2.DescribeTrafficMirrorFiltersInput{
 			TrafficMirrorFilterIds: []*string{
 				aws.String(rs.Primary.ID),
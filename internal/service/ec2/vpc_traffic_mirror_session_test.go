@@ -252,6 +252,7 @@ resource "aws_lb" "lb" {
 }
 
 resource "aws_ec2_traffic_mirror_filter" "filter" {
+  count_sessions = true
 }
 
 resource "aws_ec2_traffic_mirror_target" "target" {
@@ -70,3 +70,17 @@ func clusterAvailabilityZoneRelocationStatus_PendingValues() []string {
 	}
 
 }
+
+const (
+	snapshotScheduleIntervalUnitMinutes = "minutes"
+	snapshotScheduleIntervalUnitHours   = "hours"
+	snapshotScheduleIntervalUnitDays    = "days"
+)
+
+func snapshotScheduleIntervalUnit_Values() []string {
+	return []string{
+		snapshotScheduleIntervalUnitMinutes,
+		snapshotScheduleIntervalUnitHours,
+		snapshotScheduleIntervalUnitDays,
+	}
+}
@@ -2,4 +2,5 @@ package redshift
 
 const (
 	errCodeInvalidParameterValue = "InvalidParameterValue"
+	errCodeThrottling            = "Throttling"
 )
@@ -0,0 +1,7 @@
+package redshift
+
+// Exports for use in tests only.
+var (
+	DisassociateSnapshotScheduleClusters = disassociateSnapshotScheduleClusters
+	ModifySnapshotScheduleDefinitions    = modifySnapshotScheduleDefinitions
+)
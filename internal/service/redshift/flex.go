@@ -1,8 +1,16 @@
 package redshift
 
 import (
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ExpandParameters(configured []interface{}) []*redshift.Parameter {
@@ -74,3 +82,280 @@ func flattenSnapshotCopy(scs *redshift.ClusterSnapshotCopyStatus) []interface{}
 
 	return []interface{}{cfg}
 }
+
+// Redshift accepts rate(...) expressions in any case, e.g. RATE(6 HOURS).
+var snapshotScheduleRateExpressionRegexp = regexp.MustCompile(`(?i)^rate\((\d+) (minutes?|hours?|days?)\)$`)
+
+// snapshotScheduleIntervalMinutes returns the length of an interval in minutes,
+// used to detect the same cadence expressed in different units.
+func snapshotScheduleIntervalMinutes(value int, unit string) int {
+	switch strings.TrimSuffix(strings.ToLower(unit), "s") {
+	case "hour":
+		return value * 60
+	case "day":
+		return value * 60 * 24
+	default:
+		return value
+	}
+}
+
+// validSnapshotScheduleDefinition rejects rate(...) definitions with a cadence
+// shorter than Redshift allows. Other expressions, e.g. cron(...), are validated by the API.
+func validSnapshotScheduleDefinition(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if err := checkSnapshotScheduleRateExpression(normalizeSnapshotScheduleDefinition(value)); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %w", k, err))
+	}
+
+	return
+}
+
+// checkSnapshotScheduleRateExpression returns an error if definition is a
+// rate(...) expression more frequent than the minimum interval.
+func checkSnapshotScheduleRateExpression(definition string) error {
+	m := snapshotScheduleRateExpressionRegexp.FindStringSubmatch(definition)
+
+	if m == nil {
+		return nil
+	}
+
+	value, err := strconv.Atoi(m[1])
+
+	if err != nil {
+		return fmt.Errorf("invalid schedule definition %q: %w", definition, err)
+	}
+
+	if minutes := snapshotScheduleIntervalMinutes(value, m[2]); minutes < snapshotScheduleMinimumIntervalMinutes {
+		return fmt.Errorf("schedule definition %q takes snapshots more often than Redshift allows, the minimum interval is %d minutes", definition, snapshotScheduleMinimumIntervalMinutes)
+	}
+
+	return nil
+}
+
+// snapshotScheduleRateExpression compiles an interval into a schedule definition,
+// e.g. 6 hours into rate(6 hours) and 1 day into rate(1 day).
+func snapshotScheduleRateExpression(value int, unit string) string {
+	if value == 1 {
+		unit = strings.TrimSuffix(unit, "s")
+	}
+
+	return fmt.Sprintf("rate(%d %s)", value, unit)
+}
+
+// expandSnapshotScheduleIntervals compiles interval blocks into rate(...) schedule definitions.
+// An error is returned if an interval repeats the cadence of another interval or of a literal rate definition.
+func expandSnapshotScheduleIntervals(tfList []interface{}, definitions []*string) ([]*string, error) {
+	cadences := make(map[int]string)
+
+	for _, definition := range aws.StringValueSlice(definitions) {
+		if m := snapshotScheduleRateExpressionRegexp.FindStringSubmatch(definition); m != nil {
+			value, _ := strconv.Atoi(m[1])
+			cadences[snapshotScheduleIntervalMinutes(value, m[2])] = definition
+		}
+	}
+
+	var apiObjects []*string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		value, unit := tfMap["value"].(int), tfMap["unit"].(string)
+
+		if value == 0 || unit == "" {
+			continue
+		}
+
+		definition := snapshotScheduleRateExpression(value, unit)
+		minutes := snapshotScheduleIntervalMinutes(value, unit)
+
+		if err := checkSnapshotScheduleRateExpression(definition); err != nil {
+			return nil, fmt.Errorf("interval %d %s: %w", value, unit, err)
+		}
+
+		if existing, ok := cadences[minutes]; ok {
+			return nil, fmt.Errorf("interval %d %s repeats the cadence of %s", value, unit, existing)
+		}
+
+		cadences[minutes] = definition
+		apiObjects = append(apiObjects, aws.String(definition))
+	}
+
+	return apiObjects, nil
+}
+
+// normalizeSnapshotScheduleDefinition trims a schedule definition and collapses
+// runs of whitespace into single spaces, so that e.g. "cron(0  12 * * ? *)"
+// and "cron(0 12 * * ? *)" compare equal.
+func normalizeSnapshotScheduleDefinition(v string) string {
+	return strings.Join(strings.Fields(v), " ")
+}
+
+// snapshotScheduleDefinitionStateFunc stores schedule definitions in their normalized form.
+func snapshotScheduleDefinitionStateFunc(v interface{}) string {
+	return normalizeSnapshotScheduleDefinition(v.(string))
+}
+
+// snapshotScheduleDefinitionHash hashes the normalized form of a schedule definition.
+func snapshotScheduleDefinitionHash(v interface{}) int {
+	return schema.HashString(normalizeSnapshotScheduleDefinition(v.(string)))
+}
+
+// expandSnapshotScheduleDefinitionSet returns the normalized schedule definitions in a set.
+func expandSnapshotScheduleDefinitionSet(tfSet *schema.Set) []*string {
+	var apiObjects []*string
+
+	for _, v := range tfSet.List() {
+		apiObjects = append(apiObjects, aws.String(normalizeSnapshotScheduleDefinition(v.(string))))
+	}
+
+	return apiObjects
+}
+
+// expandSnapshotScheduleDefinitionList returns the normalized schedule definitions
+// in a list, in order. An error is returned if a definition is repeated.
+func expandSnapshotScheduleDefinitionList(tfList []interface{}) ([]*string, error) {
+	var apiObjects []*string
+	seen := make(map[string]struct{})
+
+	for _, v := range tfList {
+		definition, ok := v.(string)
+
+		if !ok {
+			continue
+		}
+
+		definition = normalizeSnapshotScheduleDefinition(definition)
+
+		if _, ok := seen[definition]; ok {
+			return nil, fmt.Errorf("ordered_definitions repeats %s", definition)
+		}
+
+		seen[definition] = struct{}{}
+		apiObjects = append(apiObjects, aws.String(definition))
+	}
+
+	return apiObjects, nil
+}
+
+// flattenSnapshotScheduleOrderedDefinitions returns the definitions in the set
+// in the order they appear in order. Definitions not in order, e.g. added
+// outside Terraform, follow in sorted order.
+func flattenSnapshotScheduleOrderedDefinitions(definitions *schema.Set, order []interface{}) []interface{} {
+	var tfList []interface{}
+	seen := make(map[string]struct{})
+
+	for _, v := range order {
+		definition, ok := v.(string)
+
+		if !ok {
+			continue
+		}
+
+		definition = normalizeSnapshotScheduleDefinition(definition)
+
+		if _, ok := seen[definition]; ok || !definitions.Contains(definition) {
+			continue
+		}
+
+		seen[definition] = struct{}{}
+		tfList = append(tfList, definition)
+	}
+
+	var remaining []string
+
+	for _, v := range definitions.List() {
+		if _, ok := seen[v.(string)]; !ok {
+			remaining = append(remaining, v.(string))
+		}
+	}
+
+	sort.Strings(remaining)
+
+	for _, v := range remaining {
+		tfList = append(tfList, v)
+	}
+
+	return tfList
+}
+
+// snapshotScheduleDefinitionsHash returns a hex encoded SHA-256 hash of the
+// sorted, normalized schedule definitions. It only changes with the cadence,
+// not with whitespace or ordering.
+func snapshotScheduleDefinitionsHash(apiObjects []*string) string {
+	definitions := make(map[string]struct{})
+
+	for _, v := range apiObjects {
+		if v == nil {
+			continue
+		}
+
+		definitions[normalizeSnapshotScheduleDefinition(aws.StringValue(v))] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(definitions))
+
+	for definition := range definitions {
+		sorted = append(sorted, definition)
+	}
+
+	sort.Strings(sorted)
+
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(sorted, "\n"))))
+}
+
+// flattenSnapshotScheduleDefinitions returns a set of normalized schedule definitions.
+func flattenSnapshotScheduleDefinitions(apiObjects []*string) *schema.Set {
+	tfSet := schema.NewSet(snapshotScheduleDefinitionHash, nil)
+
+	for _, v := range apiObjects {
+		if v == nil {
+			continue
+		}
+
+		tfSet.Add(normalizeSnapshotScheduleDefinition(aws.StringValue(v)))
+	}
+
+	return tfSet
+}
+
+// flattenSnapshotScheduleAssociatedClusters returns the clusters associated with
+// a snapshot schedule, reporting each association state exactly as returned by
+// the API (ACTIVE, MODIFYING or FAILED).
+func flattenSnapshotScheduleAssociatedClusters(apiObjects []*redshift.ClusterAssociatedToSchedule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"cluster_identifier":         aws.StringValue(apiObject.ClusterIdentifier),
+			"schedule_association_state": aws.StringValue(apiObject.ScheduleAssociationState),
+		})
+	}
+
+	return tfList
+}
+
+// snapshotScheduleHasPendingAssociations returns whether any cluster is still
+// being associated with or disassociated from a snapshot schedule.
+func snapshotScheduleHasPendingAssociations(apiObjects []*redshift.ClusterAssociatedToSchedule) bool {
+	for _, apiObject := range apiObjects {
+		if apiObject != nil && aws.StringValue(apiObject.ScheduleAssociationState) == redshift.ScheduleStateModifying {
+			return true
+		}
+	}
+
+	return false
+}
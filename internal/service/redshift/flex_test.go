@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestExpandParameters(t *testing.T) {
@@ -58,3 +59,251 @@ func TestFlattenParameters(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandSnapshotScheduleIntervals(t *testing.T) {
+	interval := func(value int, unit string) interface{} {
+		return map[string]interface{}{
+			"unit":  unit,
+			"value": value,
+		}
+	}
+
+	cases := []struct {
+		Name        string
+		Intervals   []interface{}
+		Definitions []string
+		Output      []string
+		ExpectError bool
+	}{
+		{
+			Name:   "empty",
+			Output: []string{},
+		},
+		{
+			Name:      "units",
+			Intervals: []interface{}{interval(90, "minutes"), interval(6, "hours"), interval(2, "days")},
+			Output:    []string{"rate(90 minutes)", "rate(6 hours)", "rate(2 days)"},
+		},
+		{
+			Name:      "singular",
+			Intervals: []interface{}{interval(1, "hours"), interval(1, "days")},
+			Output:    []string{"rate(1 hour)", "rate(1 day)"},
+		},
+		{
+			Name:        "distinct from literal definitions",
+			Intervals:   []interface{}{interval(6, "hours")},
+			Definitions: []string{"rate(12 hours)", "cron(30 12 *)"},
+			Output:      []string{"rate(6 hours)"},
+		},
+		{
+			Name:        "same interval twice",
+			Intervals:   []interface{}{interval(6, "hours"), interval(6, "hours")},
+			ExpectError: true,
+		},
+		{
+			Name:        "same cadence in different units",
+			Intervals:   []interface{}{interval(1, "days"), interval(24, "hours")},
+			ExpectError: true,
+		},
+		{
+			Name:        "too frequent",
+			Intervals:   []interface{}{interval(30, "minutes")},
+			ExpectError: true,
+		},
+		{
+			Name:      "minimum interval",
+			Intervals: []interface{}{interval(60, "minutes")},
+			Output:    []string{"rate(60 minutes)"},
+		},
+		{
+			Name:        "same cadence as literal definition",
+			Intervals:   []interface{}{interval(60, "minutes")},
+			Definitions: []string{"rate(1 hour)"},
+			ExpectError: true,
+		},
+		{
+			Name:        "same cadence as upper case literal definition",
+			Intervals:   []interface{}{interval(6, "hours")},
+			Definitions: []string{"RATE(6 HOURS)"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			output, err := expandSnapshotScheduleIntervals(tc.Intervals, aws.StringSlice(tc.Definitions))
+
+			if got, want := err != nil, tc.ExpectError; got != want {
+				t.Fatalf("got error %t, expected %t: %v", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got := aws.StringValueSlice(output); !reflect.DeepEqual(got, tc.Output) {
+				t.Errorf("got %q, expected %q", got, tc.Output)
+			}
+		})
+	}
+}
+
+func TestValidSnapshotScheduleDefinition(t *testing.T) {
+	cases := []struct {
+		Value       string
+		ExpectError bool
+	}{
+		{Value: "rate(12 hours)"},
+		{Value: "RATE(12 HOURS)"},
+		{Value: "cron(30 12 *)"},
+		{Value: "rate(30 minutes)", ExpectError: true},
+		{Value: "Rate(30 Minutes)", ExpectError: true},
+	}
+
+	for _, tc := range cases {
+		_, errors := validSnapshotScheduleDefinition(tc.Value, "definitions")
+
+		if got, want := len(errors) > 0, tc.ExpectError; got != want {
+			t.Errorf("%q: got error %t, expected %t: %v", tc.Value, got, want, errors)
+		}
+	}
+}
+
+func TestNormalizeSnapshotScheduleDefinition(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+	}{
+		{Input: "rate(12 hours)", Expected: "rate(12 hours)"},
+		{Input: "rate(12  hours)", Expected: "rate(12 hours)"},
+		{Input: " cron(30 12 *) ", Expected: "cron(30 12 *)"},
+		{Input: "cron(0\t12 *\n* ? *)", Expected: "cron(0 12 * * ? *)"},
+		{Input: "at(2021-01-01T00:00:00)", Expected: "at(2021-01-01T00:00:00)"},
+		{Input: "", Expected: ""},
+	}
+
+	for _, testCase := range testCases {
+		if got := normalizeSnapshotScheduleDefinition(testCase.Input); got != testCase.Expected {
+			t.Errorf("%q: got %q, expected %q", testCase.Input, got, testCase.Expected)
+		}
+	}
+}
+
+func TestFlattenSnapshotScheduleDefinitions(t *testing.T) {
+	got := flattenSnapshotScheduleDefinitions(aws.StringSlice([]string{"rate(12  hours)", "rate(12 hours)", " cron(30 12 *)"}))
+	expected := schema.NewSet(snapshotScheduleDefinitionHash, []interface{}{"rate(12 hours)", "cron(30 12 *)"})
+
+	if !got.Equal(expected) {
+		t.Errorf("got %v, expected %v", got.List(), expected.List())
+	}
+
+	// Definitions that differ only in whitespace hash the same, so the resource and
+	// data source outputs compare equal.
+	if snapshotScheduleDefinitionHash("rate(12  hours)") != snapshotScheduleDefinitionHash("rate(12 hours)") {
+		t.Error("expected equivalent definitions to hash the same")
+	}
+}
+
+func TestExpandSnapshotScheduleDefinitionList(t *testing.T) {
+	got, err := expandSnapshotScheduleDefinitionList([]interface{}{"rate(12  hours)", "cron(30 12 *)", "rate(1 day)"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"rate(12 hours)", "cron(30 12 *)", "rate(1 day)"}; !reflect.DeepEqual(aws.StringValueSlice(got), expected) {
+		t.Errorf("got %v, expected %v", aws.StringValueSlice(got), expected)
+	}
+
+	// Definitions that differ only in whitespace are duplicates.
+	if _, err := expandSnapshotScheduleDefinitionList([]interface{}{"rate(12 hours)", "cron(30 12 *)", " rate(12  hours)"}); err == nil {
+		t.Error("expected error for repeated definition")
+	}
+}
+
+func TestFlattenSnapshotScheduleOrderedDefinitions(t *testing.T) {
+	definitions := flattenSnapshotScheduleDefinitions(aws.StringSlice([]string{"rate(1 day)", "cron(30 12 *)", "rate(12 hours)", "cron(0 6 *)"}))
+	order := []interface{}{"rate(12 hours)", "rate(6 hours)", "rate(1 day)", "cron(30 12 *)"}
+
+	got := flattenSnapshotScheduleOrderedDefinitions(definitions, order)
+	expected := []interface{}{"rate(12 hours)", "rate(1 day)", "cron(30 12 *)", "cron(0 6 *)"}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestFlattenSnapshotScheduleAssociatedClusters(t *testing.T) {
+	apiObjects := []*redshift.ClusterAssociatedToSchedule{
+		{ClusterIdentifier: aws.String("cluster-1"), ScheduleAssociationState: aws.String(redshift.ScheduleStateActive)},
+		nil,
+		{ClusterIdentifier: aws.String("cluster-2"), ScheduleAssociationState: aws.String(redshift.ScheduleStateModifying)},
+		{ClusterIdentifier: aws.String("cluster-3"), ScheduleAssociationState: aws.String(redshift.ScheduleStateFailed)},
+	}
+
+	got := flattenSnapshotScheduleAssociatedClusters(apiObjects)
+	expected := []interface{}{
+		map[string]interface{}{"cluster_identifier": "cluster-1", "schedule_association_state": "ACTIVE"},
+		map[string]interface{}{"cluster_identifier": "cluster-2", "schedule_association_state": "MODIFYING"},
+		map[string]interface{}{"cluster_identifier": "cluster-3", "schedule_association_state": "FAILED"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestSnapshotScheduleDefinitionsHash(t *testing.T) {
+	base := snapshotScheduleDefinitionsHash(aws.StringSlice([]string{"rate(12 hours)", "cron(30 12 *)"}))
+
+	testCases := []struct {
+		Name        string
+		Definitions []string
+		Equal       bool
+	}{
+		{
+			Name:        "same definitions",
+			Definitions: []string{"rate(12 hours)", "cron(30 12 *)"},
+			Equal:       true,
+		},
+		{
+			Name:        "whitespace only",
+			Definitions: []string{" rate(12  hours)", "cron(30\t12 *) "},
+			Equal:       true,
+		},
+		{
+			Name:        "reordered",
+			Definitions: []string{"cron(30 12 *)", "rate(12 hours)"},
+			Equal:       true,
+		},
+		{
+			Name:        "duplicate",
+			Definitions: []string{"rate(12 hours)", "cron(30 12 *)", "rate(12  hours)"},
+			Equal:       true,
+		},
+		{
+			Name:        "changed cadence",
+			Definitions: []string{"rate(6 hours)", "cron(30 12 *)"},
+		},
+		{
+			Name:        "removed definition",
+			Definitions: []string{"rate(12 hours)"},
+		},
+		{
+			Name:        "added definition",
+			Definitions: []string{"rate(12 hours)", "cron(30 12 *)", "cron(0 0 *)"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := snapshotScheduleDefinitionsHash(aws.StringSlice(testCase.Definitions))
+
+			if equal := got == base; equal != testCase.Equal {
+				t.Errorf("got hash %s for %q, expected equal to %s: %t", got, testCase.Definitions, base, testCase.Equal)
+			}
+		})
+	}
+}
@@ -1,29 +1,57 @@
 package redshift
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	// Maximum number of schedule definitions accepted in a single snapshot schedule.
+	// Exceeding it is otherwise only reported by the API at apply time.
+	snapshotScheduleDefinitionsMaxItems = 100
+
+	// Maximum number of clusters disassociated from a snapshot schedule in parallel on force destroy.
+	snapshotScheduleDisassociateConcurrency = 5
+
+	// Maximum amount of time to retry describing a snapshot schedule while Redshift is throttling requests.
+	snapshotScheduleReadThrottleTimeout = 2 * time.Minute
+
+	// Maximum amount of time to wait for a newly created snapshot schedule to become visible.
+	snapshotSchedulePropagationTimeout = 2 * time.Minute
+
+	// Shortest cadence, in minutes, that Redshift accepts for a rate(...) schedule definition.
+	snapshotScheduleMinimumIntervalMinutes = 60
+)
+
 func ResourceSnapshotSchedule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSnapshotScheduleCreate,
-		Read:   resourceSnapshotScheduleRead,
-		Update: resourceSnapshotScheduleUpdate,
-		Delete: resourceSnapshotScheduleDelete,
+		CreateContext: resourceSnapshotScheduleCreate,
+		ReadContext:   resourceSnapshotScheduleRead,
+		UpdateContext: resourceSnapshotScheduleUpdate,
+		DeleteContext: resourceSnapshotScheduleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceSnapshotScheduleImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -31,12 +59,26 @@ func ResourceSnapshotSchedule() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"associated_clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schedule_association_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"identifier": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Computed:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"identifier_prefix"},
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
 			},
 			"identifier_prefix": {
 				Type:     schema.TypeString,
@@ -50,165 +92,601 @@ func ResourceSnapshotSchedule() *schema.Resource {
 				ForceNew: true,
 			},
 			"definitions": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				AtLeastOneOf:  []string{"definitions", "interval", "ordered_definitions"},
+				ConflictsWith: []string{"ordered_definitions"},
+				MaxItems:      snapshotScheduleDefinitionsMaxItems,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.All(
+						validation.StringIsNotWhiteSpace,
+						validSnapshotScheduleDefinition,
+					),
+					StateFunc: snapshotScheduleDefinitionStateFunc,
+				},
+				Set: snapshotScheduleDefinitionHash,
+			},
+			"definitions_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"interval": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"definitions", "interval", "ordered_definitions"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unit": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(snapshotScheduleIntervalUnit_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
 			},
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"has_pending_associations": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ordered_definitions": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				AtLeastOneOf:  []string{"definitions", "interval", "ordered_definitions"},
+				ConflictsWith: []string{"definitions"},
+				MaxItems:      snapshotScheduleDefinitionsMaxItems,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.All(
+						validation.StringIsNotWhiteSpace,
+						validSnapshotScheduleDefinition,
+					),
+					StateFunc: snapshotScheduleDefinitionStateFunc,
+				},
+			},
+			"preserve_associations_on_rename": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"validate_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			resourceSnapshotScheduleIdentifierDiff,
+			resourceSnapshotScheduleRenameDiff,
+			resourceSnapshotScheduleIntervalDiff,
+			customdiff.ComputedIf("definitions_hash", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("definitions") || diff.HasChange("interval") || diff.HasChange("ordered_definitions")
+			}),
+		),
+	}
+
+}
+
+// snapshotScheduleValidateOnlyIdentifier returns an identifier with the given
+// prefix and a suffix computed from the schedule definitions, with the same
+// length as the suffix of a generated unique identifier.
+func snapshotScheduleValidateOnlyIdentifier(prefix string, definitions []*string) string {
+	return prefix + snapshotScheduleDefinitionsHash(definitions)[:resource.UniqueIDSuffixLength]
+}
+
+// resourceSnapshotScheduleIdentifierDiff rejects configurations that set both
+// identifier and identifier_prefix and logs how the identifier of a new
+// schedule will be generated when neither is set.
+func resourceSnapshotScheduleIdentifierDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	identifierSet := !config.GetAttr("identifier").IsNull()
+	identifierPrefixSet := !config.GetAttr("identifier_prefix").IsNull()
+
+	if identifierSet && identifierPrefixSet {
+		return errors.New("only one of `identifier` or `identifier_prefix` can be set; use `identifier` for an exact name or `identifier_prefix` for a generated name with that prefix")
+	}
+
+	if diff.Id() == "" && !identifierSet && !identifierPrefixSet {
+		log.Printf("[INFO] Neither identifier nor identifier_prefix is set, Redshift Snapshot Schedule identifier will be generated with prefix %q", resource.UniqueIdPrefix)
+	}
+
+	return nil
+}
+
+// resourceSnapshotScheduleRenameDiff replaces the schedule when its identifier
+// changes, unless preserve_associations_on_rename is set, in which case the
+// update swaps in a new schedule and moves the associated clusters to it.
+func resourceSnapshotScheduleRenameDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("identifier") {
+		return nil
+	}
+
+	if diff.Get("preserve_associations_on_rename").(bool) && !diff.Get("validate_only").(bool) {
+		return nil
+	}
+
+	return diff.ForceNew("identifier")
+}
+
+// resourceSnapshotScheduleIntervalDiff rejects intervals that repeat an existing cadence,
+// repeated ordered definitions and definitions that together exceed the limit.
+func resourceSnapshotScheduleIntervalDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	_, err := expandSnapshotScheduleDefinitions(diff.Get("definitions").(*schema.Set), diff.Get("ordered_definitions").([]interface{}), diff.Get("interval").([]interface{}))
+
+	return err
+}
+
+// expandSnapshotScheduleDefinitions returns the literal definitions, from either
+// definitions or ordered_definitions, merged with those compiled from interval blocks.
+func expandSnapshotScheduleDefinitions(definitions *schema.Set, orderedDefinitions []interface{}, intervals []interface{}) ([]*string, error) {
+	apiObjects := expandSnapshotScheduleDefinitionSet(definitions)
+
+	ordered, err := expandSnapshotScheduleDefinitionList(orderedDefinitions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiObjects = append(apiObjects, ordered...)
+
+	compiled, err := expandSnapshotScheduleIntervals(intervals, apiObjects)
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiObjects = append(apiObjects, compiled...)
+
+	if len(apiObjects) > snapshotScheduleDefinitionsMaxItems {
+		return nil, fmt.Errorf("definitions and interval together specify %d schedule definitions, the maximum is %d", len(apiObjects), snapshotScheduleDefinitionsMaxItems)
+	}
+
+	return apiObjects, nil
+}
+
+// resourceSnapshotScheduleImport accepts either the schedule identifier or its ARN.
+func resourceSnapshotScheduleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !arn.IsARN(d.Id()) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	identifier, err := snapshotScheduleIdentifierFromARN(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(identifier)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func snapshotScheduleIdentifierFromARN(v string) (string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing Redshift Snapshot Schedule ARN (%s): %w", v, err)
 	}
 
+	if parsedARN.Service != redshift.ServiceName {
+		return "", fmt.Errorf("ARN (%s) is for service %q, expected a Redshift Snapshot Schedule ARN (arn:PARTITION:redshift:REGION:ACCOUNT:snapshotschedule:IDENTIFIER)", v, parsedARN.Service)
+	}
+
+	identifier := strings.TrimPrefix(parsedARN.Resource, "snapshotschedule:")
+
+	if identifier == parsedARN.Resource || identifier == "" {
+		return "", fmt.Errorf("ARN (%s) is not a Redshift Snapshot Schedule ARN (arn:PARTITION:redshift:REGION:ACCOUNT:snapshotschedule:IDENTIFIER)", v)
+	}
+
+	return identifier, nil
 }
 
-func resourceSnapshotScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceSnapshotScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RedshiftConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	definitions, err := expandSnapshotScheduleDefinitions(d.Get("definitions").(*schema.Set), d.Get("ordered_definitions").([]interface{}), d.Get("interval").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	var identifier string
 	if v, ok := d.GetOk("identifier"); ok {
 		identifier = v.(string)
 	} else {
+		prefix := resource.UniqueIdPrefix
 		if v, ok := d.GetOk("identifier_prefix"); ok {
-			identifier = resource.PrefixedUniqueId(v.(string))
+			prefix = v.(string)
+		}
+
+		// A validate-only schedule is never persisted, so its identifier is
+		// derived from the configuration to keep the resource ID stable.
+		if d.Get("validate_only").(bool) {
+			identifier = snapshotScheduleValidateOnlyIdentifier(prefix, definitions)
 		} else {
-			identifier = resource.UniqueId()
+			identifier = resource.PrefixedUniqueId(prefix)
 		}
 	}
+
 	createOpts := &redshift.CreateSnapshotScheduleInput{
 		ScheduleIdentifier:  aws.String(identifier),
-		ScheduleDefinitions: flex.ExpandStringSet(d.Get("definitions").(*schema.Set)),
+		ScheduleDefinitions: definitions,
 		Tags:                Tags(tags.IgnoreAWS()),
 	}
 	if attr, ok := d.GetOk("description"); ok {
 		createOpts.ScheduleDescription = aws.String(attr.(string))
 	}
 
-	resp, err := conn.CreateSnapshotSchedule(createOpts)
+	// A validate-only schedule is checked by the API but never persisted,
+	// so the requested identifier is used as the resource ID.
+	if d.Get("validate_only").(bool) {
+		createOpts.DryRun = aws.Bool(true)
+
+		if _, err := conn.CreateSnapshotScheduleWithContext(ctx, createOpts); err != nil {
+			return diag.Errorf("Error validating Redshift Snapshot Schedule: %s", err)
+		}
+
+		d.SetId(identifier)
+
+		return resourceSnapshotScheduleRead(ctx, d, meta)
+	}
+
+	resp, err := conn.CreateSnapshotScheduleWithContext(ctx, createOpts)
 	if err != nil {
-		return fmt.Errorf("Error creating Redshift Snapshot Schedule: %s", err)
+		return diag.Errorf("Error creating Redshift Snapshot Schedule: %s", err)
 	}
 
 	d.SetId(aws.StringValue(resp.ScheduleIdentifier))
 
-	return resourceSnapshotScheduleRead(d, meta)
+	return resourceSnapshotScheduleRead(ctx, d, meta)
 }
 
-func resourceSnapshotScheduleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceSnapshotScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RedshiftConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	descOpts := &redshift.DescribeSnapshotSchedulesInput{
-		ScheduleIdentifier: aws.String(d.Id()),
-	}
+	arn, err := meta.(*conns.AWSClient).RegionalARN(redshift.ServiceName, fmt.Sprintf("snapshotschedule:%s", d.Id()))
 
-	resp, err := conn.DescribeSnapshotSchedules(descOpts)
 	if err != nil {
-		return fmt.Errorf("Error describing Redshift Cluster Snapshot Schedule %s: %s", d.Id(), err)
+		return diag.Errorf("error reading Redshift Snapshot Schedule (%s): %s", d.Id(), err)
 	}
 
-	if resp.SnapshotSchedules == nil || len(resp.SnapshotSchedules) != 1 {
-		log.Printf("[WARN] Unable to find Redshift Cluster Snapshot Schedule (%s)", d.Id())
+	// Avoid a diff for schedules created or imported before the argument existed.
+	if _, ok := d.GetOkExists("preserve_associations_on_rename"); !ok {
+		d.Set("preserve_associations_on_rename", false)
+	}
+
+	if d.Get("validate_only").(bool) {
+		tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{}))).IgnoreConfig(ignoreTagsConfig)
+
+		definitions, _ := expandSnapshotScheduleDefinitions(d.Get("definitions").(*schema.Set), d.Get("ordered_definitions").([]interface{}), d.Get("interval").([]interface{}))
+
+		d.Set("arn", arn)
+		d.Set("associated_clusters", nil)
+		d.Set("definitions_hash", snapshotScheduleDefinitionsHash(definitions))
+		d.Set("has_pending_associations", false)
+		d.Set("identifier", d.Id())
+
+		if err := d.Set("tags_all", tags.Map()); err != nil {
+			return diag.Errorf("error setting tags_all: %s", err)
+		}
+
+		return nil
+	}
+
+	// A schedule that was just created may not be visible to DescribeSnapshotSchedules yet.
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, snapshotSchedulePropagationTimeout,
+		func() (interface{}, error) {
+			return tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, snapshotScheduleReadThrottleTimeout,
+				func() (interface{}, error) {
+					return FindSnapshotScheduleByID(ctx, conn, d.Id())
+				},
+				errCodeThrottling, redshift.ErrCodeDependentServiceRequestThrottlingFault)
+		},
+		d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Cluster Snapshot Schedule (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
-	snapshotSchedule := resp.SnapshotSchedules[0]
+
+	if err != nil {
+		return diag.Errorf("Error describing Redshift Cluster Snapshot Schedule %s: %s", d.Id(), err)
+	}
+
+	snapshotSchedule := outputRaw.(*redshift.SnapshotSchedule)
 
 	d.Set("identifier", snapshotSchedule.ScheduleIdentifier)
 	d.Set("description", snapshotSchedule.ScheduleDescription)
-	if err := d.Set("definitions", flex.FlattenStringList(snapshotSchedule.ScheduleDefinitions)); err != nil {
-		return fmt.Errorf("Error setting definitions: %s", err)
+
+	// Definitions compiled from interval blocks are tracked by the interval argument.
+	compiled, _ := expandSnapshotScheduleIntervals(d.Get("interval").([]interface{}), nil)
+	definitions := flattenSnapshotScheduleDefinitions(snapshotSchedule.ScheduleDefinitions).Difference(flattenSnapshotScheduleDefinitions(compiled))
+
+	// Redshift does not keep the order of definitions, so ordered definitions
+	// are read back in the order already in state.
+	if orderedDefinitions := d.Get("ordered_definitions").([]interface{}); len(orderedDefinitions) > 0 {
+		if err := d.Set("ordered_definitions", flattenSnapshotScheduleOrderedDefinitions(definitions, orderedDefinitions)); err != nil {
+			return diag.Errorf("Error setting ordered_definitions: %s", err)
+		}
+
+		definitions = schema.NewSet(snapshotScheduleDefinitionHash, nil)
+	} else {
+		d.Set("ordered_definitions", nil)
+	}
+
+	if err := d.Set("definitions", definitions); err != nil {
+		return diag.Errorf("Error setting definitions: %s", err)
 	}
 
-	tags := KeyValueTags(snapshotSchedule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	d.Set("definitions_hash", snapshotScheduleDefinitionsHash(snapshotSchedule.ScheduleDefinitions))
+
+	if err := d.Set("associated_clusters", flattenSnapshotScheduleAssociatedClusters(snapshotSchedule.AssociatedClusters)); err != nil {
+		return diag.Errorf("error setting associated_clusters: %s", err)
+	}
+
+	d.Set("has_pending_associations", snapshotScheduleHasPendingAssociations(snapshotSchedule.AssociatedClusters))
+
+	// The describe payload can lag behind recently applied tags, so prefer
+	// the tags listed for the schedule ARN and fall back to the payload.
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		log.Printf("[WARN] Error listing tags for Redshift Snapshot Schedule (%s), using described tags: %s", d.Id(), err)
+		tags = KeyValueTags(snapshotSchedule.Tags)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+	if err := d.Set("tags", tags.RemoveDefaultConfigExcept(defaultTagsConfig, tftags.New(d.Get("tags").(map[string]interface{}))).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "redshift",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("snapshotschedule:%s", d.Id()),
-	}.String()
-
 	d.Set("arn", arn)
 
 	return nil
 }
 
-func resourceSnapshotScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceSnapshotScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RedshiftConn
 
+	if d.Get("validate_only").(bool) {
+		if d.HasChanges("definitions", "interval", "ordered_definitions") {
+			definitions, err := expandSnapshotScheduleDefinitions(d.Get("definitions").(*schema.Set), d.Get("ordered_definitions").([]interface{}), d.Get("interval").([]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			input := &redshift.CreateSnapshotScheduleInput{
+				DryRun:              aws.Bool(true),
+				ScheduleDefinitions: definitions,
+				ScheduleIdentifier:  aws.String(d.Id()),
+			}
+
+			if _, err := conn.CreateSnapshotScheduleWithContext(ctx, input); err != nil {
+				return diag.Errorf("Error validating Redshift Snapshot Schedule %s: %s", d.Id(), err)
+			}
+		}
+
+		return resourceSnapshotScheduleRead(ctx, d, meta)
+	}
+
+	// Only reachable with preserve_associations_on_rename, otherwise an
+	// identifier change replaces the resource. The new schedule is created
+	// with the current configuration, so nothing else needs updating.
+	if d.HasChange("identifier") {
+		if err := resourceSnapshotScheduleRename(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceSnapshotScheduleRead(ctx, d, meta)
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating Redshift Snapshot Schedule (%s) tags: %s", d.Get("arn").(string), err)
+			return diag.Errorf("error updating Redshift Snapshot Schedule (%s) tags: %s", d.Get("arn").(string), err)
 		}
 	}
 
-	if d.HasChange("definitions") {
-		modifyOpts := &redshift.ModifySnapshotScheduleInput{
-			ScheduleIdentifier:  aws.String(d.Id()),
-			ScheduleDefinitions: flex.ExpandStringSet(d.Get("definitions").(*schema.Set)),
+	if d.HasChanges("definitions", "interval", "ordered_definitions") {
+		definitions, err := expandSnapshotScheduleDefinitions(d.Get("definitions").(*schema.Set), d.Get("ordered_definitions").([]interface{}), d.Get("interval").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		_, err := conn.ModifySnapshotSchedule(modifyOpts)
+
+		err = modifySnapshotScheduleDefinitions(ctx, conn, d.Id(), definitions)
 		if tfawserr.ErrCodeEquals(err, redshift.ErrCodeSnapshotScheduleNotFoundFault) {
 			log.Printf("[WARN] Redshift Snapshot Schedule (%s) not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
 		if err != nil {
-			return fmt.Errorf("Error modifying Redshift Snapshot Schedule %s: %s", d.Id(), err)
+			return diag.Errorf("Error modifying Redshift Snapshot Schedule %s: %s", d.Id(), err)
 		}
 	}
 
-	return resourceSnapshotScheduleRead(d, meta)
+	return resourceSnapshotScheduleRead(ctx, d, meta)
 }
 
-func resourceSnapshotScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+// resourceSnapshotScheduleRename creates a schedule with the new identifier,
+// moves the clusters associated with the old schedule to it and then deletes
+// the old schedule. On failure the old identifier is kept in state, and a new
+// schedule left over from a previous attempt is reused.
+func resourceSnapshotScheduleRename(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RedshiftConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	if d.Get("force_destroy").(bool) {
-		if err := resourceSnapshotScheduleDeleteAllAssociatedClusters(conn, d.Id()); err != nil {
+	d.Partial(true)
+
+	oldIdentifier := d.Id()
+	newIdentifier := d.Get("identifier").(string)
+
+	oldSnapshotSchedule, err := FindSnapshotScheduleByID(ctx, conn, oldIdentifier)
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Snapshot Schedule (%s): %w", oldIdentifier, err)
+	}
+
+	_, err = FindSnapshotScheduleByID(ctx, conn, newIdentifier)
+
+	switch {
+	case tfresource.NotFound(err):
+		definitions, err := expandSnapshotScheduleDefinitions(d.Get("definitions").(*schema.Set), d.Get("ordered_definitions").([]interface{}), d.Get("interval").([]interface{}))
+
+		if err != nil {
 			return err
 		}
+
+		input := &redshift.CreateSnapshotScheduleInput{
+			ScheduleIdentifier:  aws.String(newIdentifier),
+			ScheduleDefinitions: definitions,
+			Tags:                Tags(tags.IgnoreAWS()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.ScheduleDescription = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Redshift Snapshot Schedule (%s) to rename Snapshot Schedule (%s)", newIdentifier, oldIdentifier)
+		if _, err := conn.CreateSnapshotScheduleWithContext(ctx, input); err != nil {
+			return fmt.Errorf("error creating Redshift Snapshot Schedule (%s): %w", newIdentifier, err)
+		}
+	case err != nil:
+		return fmt.Errorf("error reading Redshift Snapshot Schedule (%s): %w", newIdentifier, err)
+	default:
+		log.Printf("[INFO] Redshift Snapshot Schedule (%s) already exists, reusing it to rename Snapshot Schedule (%s)", newIdentifier, oldIdentifier)
+	}
+
+	for _, associatedCluster := range oldSnapshotSchedule.AssociatedClusters {
+		clusterIdentifier := aws.StringValue(associatedCluster.ClusterIdentifier)
+
+		log.Printf("[INFO] Associating Redshift Cluster (%s) with Snapshot Schedule (%s)", clusterIdentifier, newIdentifier)
+		_, err := conn.ModifyClusterSnapshotScheduleWithContext(ctx, &redshift.ModifyClusterSnapshotScheduleInput{
+			ClusterIdentifier:    aws.String(clusterIdentifier),
+			ScheduleIdentifier:   aws.String(newIdentifier),
+			DisassociateSchedule: aws.Bool(false),
+		})
+
+		if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterNotFoundFault) {
+			log.Printf("[WARN] Redshift Cluster (%s) not found, not associating it with Snapshot Schedule (%s)", clusterIdentifier, newIdentifier)
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error associating Redshift Cluster (%s) with Snapshot Schedule (%s): %w", clusterIdentifier, newIdentifier, err)
+		}
+
+		if err := waitSnapshotScheduleAssociationMoved(ctx, conn, snapshotScheduleAssociationActivatedTimeout, clusterIdentifier, newIdentifier); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Snapshot Schedule (%s) after rename to (%s)", oldIdentifier, newIdentifier)
+	_, err = conn.DeleteSnapshotScheduleWithContext(ctx, &redshift.DeleteSnapshotScheduleInput{
+		ScheduleIdentifier: aws.String(oldIdentifier),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, redshift.ErrCodeSnapshotScheduleNotFoundFault) {
+		return fmt.Errorf("error deleting Redshift Snapshot Schedule (%s) after rename to (%s): %w", oldIdentifier, newIdentifier, err)
+	}
+
+	d.SetId(newIdentifier)
+	d.Partial(false)
+
+	return nil
+}
+
+// modifySnapshotScheduleDefinitions replaces the definitions of a snapshot
+// schedule and reads them back, returning an error if Redshift did not apply
+// exactly the requested definitions.
+func modifySnapshotScheduleDefinitions(ctx context.Context, conn *redshift.Redshift, scheduleIdentifier string, definitions []*string) error {
+	_, err := conn.ModifySnapshotScheduleWithContext(ctx, &redshift.ModifySnapshotScheduleInput{
+		ScheduleIdentifier:  aws.String(scheduleIdentifier),
+		ScheduleDefinitions: definitions,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	snapshotSchedule, err := FindSnapshotScheduleByID(ctx, conn, scheduleIdentifier)
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Snapshot Schedule (%s) after modifying definitions: %w", scheduleIdentifier, err)
+	}
+
+	requested := flattenSnapshotScheduleDefinitions(definitions)
+	applied := flattenSnapshotScheduleDefinitions(snapshotSchedule.ScheduleDefinitions)
+
+	if missing, unexpected := requested.Difference(applied), applied.Difference(requested); missing.Len() > 0 || unexpected.Len() > 0 {
+		return fmt.Errorf("Redshift Snapshot Schedule (%s) definitions differ from requested after modification: missing %q, unexpected %q", scheduleIdentifier, aws.StringValueSlice(flex.ExpandStringSet(missing)), aws.StringValueSlice(flex.ExpandStringSet(unexpected)))
 	}
 
-	_, err := conn.DeleteSnapshotSchedule(&redshift.DeleteSnapshotScheduleInput{
+	return nil
+}
+
+func resourceSnapshotScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	if d.Get("validate_only").(bool) {
+		return nil
+	}
+
+	// If any cluster fails to disassociate, the schedule is not deleted and
+	// is kept in state, so that destroying again retries the remaining clusters.
+	if d.Get("force_destroy").(bool) {
+		if err := resourceSnapshotScheduleDeleteAllAssociatedClusters(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("error deleting Redshift Snapshot Schedule (%s): %s", d.Id(), err)
+		}
+	}
+
+	_, err := conn.DeleteSnapshotScheduleWithContext(ctx, &redshift.DeleteSnapshotScheduleInput{
 		ScheduleIdentifier: aws.String(d.Id()),
 	})
 	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeSnapshotScheduleNotFoundFault) {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error deleting Redshift Snapshot Schedule %s: %s", d.Id(), err)
+		return diag.Errorf("Error deleting Redshift Snapshot Schedule %s: %s", d.Id(), err)
 	}
 
 	return nil
 }
 
-func resourceSnapshotScheduleDeleteAllAssociatedClusters(conn *redshift.Redshift, scheduleIdentifier string) error {
+func resourceSnapshotScheduleDeleteAllAssociatedClusters(ctx context.Context, conn *redshift.Redshift, scheduleIdentifier string) error {
 
-	resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
+	resp, err := conn.DescribeSnapshotSchedulesWithContext(ctx, &redshift.DescribeSnapshotSchedulesInput{
 		ScheduleIdentifier: aws.String(scheduleIdentifier),
 	})
 	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeSnapshotScheduleNotFoundFault) {
@@ -224,30 +702,142 @@ func resourceSnapshotScheduleDeleteAllAssociatedClusters(conn *redshift.Redshift
 
 	snapshotSchedule := resp.SnapshotSchedules[0]
 
+	// Clusters no longer associated are not listed. Clusters that are already
+	// being modified cannot be modified again until they settle: those that
+	// were being disassociated, e.g. by a previous attempt that failed part way
+	// through, are gone afterwards, while those that were being associated end
+	// up active and are disassociated then.
+	var clusterIdentifiers, disassociateClusterIdentifiers, modifyingClusterIdentifiers []string
 	for _, associatedCluster := range snapshotSchedule.AssociatedClusters {
-		_, err = conn.ModifyClusterSnapshotSchedule(&redshift.ModifyClusterSnapshotScheduleInput{
-			ClusterIdentifier:    associatedCluster.ClusterIdentifier,
-			ScheduleIdentifier:   aws.String(scheduleIdentifier),
-			DisassociateSchedule: aws.Bool(true),
-		})
+		clusterIdentifier := aws.StringValue(associatedCluster.ClusterIdentifier)
+		clusterIdentifiers = append(clusterIdentifiers, clusterIdentifier)
 
-		if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterNotFoundFault) {
-			log.Printf("[WARN] Redshift Snapshot Cluster (%s) not found, removing from state", aws.StringValue(associatedCluster.ClusterIdentifier))
+		if state := aws.StringValue(associatedCluster.ScheduleAssociationState); state == redshift.ScheduleStateModifying {
+			log.Printf("[INFO] Redshift Cluster (%s) Snapshot Schedule (%s) Association is %s, waiting for it before disassociating", clusterIdentifier, scheduleIdentifier, state)
+			modifyingClusterIdentifiers = append(modifyingClusterIdentifiers, clusterIdentifier)
 			continue
 		}
-		if tfawserr.ErrCodeEquals(err, redshift.ErrCodeSnapshotScheduleNotFoundFault) {
-			log.Printf("[WARN] Redshift Snapshot Schedule (%s) not found, removing from state", scheduleIdentifier)
+
+		disassociateClusterIdentifiers = append(disassociateClusterIdentifiers, clusterIdentifier)
+	}
+
+	if err := disassociateSnapshotScheduleClusters(ctx, conn, scheduleIdentifier, disassociateClusterIdentifiers); err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+
+	disassociateClusterIdentifiers = nil
+	for _, clusterIdentifier := range modifyingClusterIdentifiers {
+		active, err := waitSnapshotScheduleAssociationSettled(ctx, conn, snapshotScheduleAssociationDestroyedTimeout, clusterIdentifier, scheduleIdentifier)
+
+		if err != nil {
+			errs = multierror.Append(errs, err)
 			continue
 		}
-		if err != nil {
-			return fmt.Errorf("Error disassociate Redshift Cluster (%s) and Snapshot Schedule (%s) Association: %s", aws.StringValue(associatedCluster.ClusterIdentifier), scheduleIdentifier, err)
+
+		if active {
+			disassociateClusterIdentifiers = append(disassociateClusterIdentifiers, clusterIdentifier)
 		}
 	}
 
-	for _, associatedCluster := range snapshotSchedule.AssociatedClusters {
-		if err := waitForRedshiftSnapshotScheduleAssociationDestroy(conn, snapshotScheduleAssociationDestroyedTimeout, aws.StringValue(associatedCluster.ClusterIdentifier), scheduleIdentifier); err != nil {
-			return err
+	if err := errs.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	if err := disassociateSnapshotScheduleClusters(ctx, conn, scheduleIdentifier, disassociateClusterIdentifiers); err != nil {
+		return err
+	}
+
+	for _, clusterIdentifier := range clusterIdentifiers {
+		if err := waitForRedshiftSnapshotScheduleAssociationDestroy(ctx, conn, snapshotScheduleAssociationDestroyedTimeout, clusterIdentifier, scheduleIdentifier); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// disassociateSnapshotScheduleClusters disassociates the specified clusters from the snapshot schedule in parallel.
+// All clusters are attempted unless the context is canceled, and any failures, including clusters
+// not attempted, are returned in a single aggregated error.
+func disassociateSnapshotScheduleClusters(ctx context.Context, conn *redshift.Redshift, scheduleIdentifier string, clusterIdentifiers []string) error {
+	var mu sync.Mutex
+	var errs *multierror.Error
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, snapshotScheduleDisassociateConcurrency)
+
+	var launched int
+
+	for _, clusterIdentifier := range clusterIdentifiers {
+		clusterIdentifier := clusterIdentifier
+
+		// No more disassociations are started once the context is canceled.
+		if ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
 		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		launched++
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			log.Printf("[INFO] Disassociating Redshift Cluster (%s) from Snapshot Schedule (%s)", clusterIdentifier, scheduleIdentifier)
+
+			if err := resourceSnapshotScheduleDisassociateCluster(ctx, conn, clusterIdentifier, scheduleIdentifier); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+				return
+			}
+
+			log.Printf("[INFO] Disassociated Redshift Cluster (%s) from Snapshot Schedule (%s)", clusterIdentifier, scheduleIdentifier)
+		}()
+	}
+
+	wg.Wait()
+
+	for _, clusterIdentifier := range clusterIdentifiers[launched:] {
+		errs = multierror.Append(errs, fmt.Errorf("Error disassociate Redshift Cluster (%s) and Snapshot Schedule (%s) Association: %w", clusterIdentifier, scheduleIdentifier, ctx.Err()))
+	}
+
+	if errs.ErrorOrNil() != nil {
+		return fmt.Errorf("error disassociating %d of %d Redshift Clusters from Snapshot Schedule (%s): %w", errs.Len(), len(clusterIdentifiers), scheduleIdentifier, errs)
+	}
+
+	return nil
+}
+
+func resourceSnapshotScheduleDisassociateCluster(ctx context.Context, conn *redshift.Redshift, clusterIdentifier, scheduleIdentifier string) error {
+	_, err := conn.ModifyClusterSnapshotScheduleWithContext(ctx, &redshift.ModifyClusterSnapshotScheduleInput{
+		ClusterIdentifier:    aws.String(clusterIdentifier),
+		ScheduleIdentifier:   aws.String(scheduleIdentifier),
+		DisassociateSchedule: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterNotFoundFault) {
+		log.Printf("[WARN] Redshift Snapshot Cluster (%s) not found, removing from state", clusterIdentifier)
+		return nil
+	}
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeSnapshotScheduleNotFoundFault) {
+		log.Printf("[WARN] Redshift Snapshot Schedule (%s) not found, removing from state", scheduleIdentifier)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error disassociate Redshift Cluster (%s) and Snapshot Schedule (%s) Association: %s", clusterIdentifier, scheduleIdentifier, err)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package redshift
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -143,7 +144,7 @@ func resourceSnapshotScheduleAssociationDelete(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error disassociate Redshift Cluster (%s) and Snapshot Schedule (%s) Association: %s", clusterIdentifier, scheduleIdentifier, err)
 	}
 
-	if err := waitForRedshiftSnapshotScheduleAssociationDestroy(conn, snapshotScheduleAssociationDestroyedTimeout, clusterIdentifier, scheduleIdentifier); err != nil {
+	if err := waitForRedshiftSnapshotScheduleAssociationDestroy(context.Background(), conn, snapshotScheduleAssociationDestroyedTimeout, clusterIdentifier, scheduleIdentifier); err != nil {
 		return err
 	}
 
@@ -162,10 +163,10 @@ func SnapshotScheduleAssociationParseID(id string) (clusterIdentifier, scheduleI
 	return
 }
 
-func resourceSnapshotScheduleAssociationStateRefreshFunc(clusterIdentifier, scheduleIdentifier string, conn *redshift.Redshift) resource.StateRefreshFunc {
+func resourceSnapshotScheduleAssociationStateRefreshFunc(ctx context.Context, clusterIdentifier, scheduleIdentifier string, conn *redshift.Redshift) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[INFO] Reading Redshift Cluster (%s) Snapshot Schedule (%s) Association Information", clusterIdentifier, scheduleIdentifier)
-		resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
+		resp, err := conn.DescribeSnapshotSchedulesWithContext(ctx, &redshift.DescribeSnapshotSchedulesInput{
 			ClusterIdentifier:  aws.String(clusterIdentifier),
 			ScheduleIdentifier: aws.String(scheduleIdentifier),
 		})
@@ -208,7 +209,7 @@ func WaitForSnapshotScheduleAssociationActive(conn *redshift.Redshift, timeout t
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{redshift.ScheduleStateModifying},
 		Target:     []string{redshift.ScheduleStateActive},
-		Refresh:    resourceSnapshotScheduleAssociationStateRefreshFunc(clusterIdentifier, scheduleIdentifier, conn),
+		Refresh:    resourceSnapshotScheduleAssociationStateRefreshFunc(context.Background(), clusterIdentifier, scheduleIdentifier, conn),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
@@ -221,17 +222,59 @@ func WaitForSnapshotScheduleAssociationActive(conn *redshift.Redshift, timeout t
 	return nil
 }
 
-func waitForRedshiftSnapshotScheduleAssociationDestroy(conn *redshift.Redshift, timeout time.Duration, clusterIdentifier, scheduleIdentifier string) error {
+// waitSnapshotScheduleAssociationMoved waits for a cluster moved to another
+// snapshot schedule to become active on it. The new association may not be
+// reported immediately after the modification, so its absence is retried.
+func waitSnapshotScheduleAssociationMoved(ctx context.Context, conn *redshift.Redshift, timeout time.Duration, clusterIdentifier, scheduleIdentifier string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{redshift.ScheduleStateModifying, "destroyed"},
+		Target:     []string{redshift.ScheduleStateActive},
+		Refresh:    resourceSnapshotScheduleAssociationStateRefreshFunc(ctx, clusterIdentifier, scheduleIdentifier, conn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for Redshift Cluster (%s) and Snapshot Schedule (%s) Association state to be \"ACTIVE\": %w", clusterIdentifier, scheduleIdentifier, err)
+	}
+
+	return nil
+}
+
+// waitSnapshotScheduleAssociationSettled waits for a cluster association that
+// is being modified to leave the "MODIFYING" state, which is reported both while
+// associating and while disassociating, and returns whether it ended up active.
+func waitSnapshotScheduleAssociationSettled(ctx context.Context, conn *redshift.Redshift, timeout time.Duration, clusterIdentifier, scheduleIdentifier string) (bool, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{redshift.ScheduleStateModifying},
+		Target:     []string{redshift.ScheduleStateActive, "destroyed"},
+		Refresh:    resourceSnapshotScheduleAssociationStateRefreshFunc(ctx, clusterIdentifier, scheduleIdentifier, conn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if err != nil {
+		return false, fmt.Errorf("error waiting for Redshift Cluster (%s) and Snapshot Schedule (%s) Association to stop modifying: %w", clusterIdentifier, scheduleIdentifier, err)
+	}
+
+	_, active := outputRaw.(*redshift.ClusterAssociatedToSchedule)
+
+	return active, nil
+}
+
+func waitForRedshiftSnapshotScheduleAssociationDestroy(ctx context.Context, conn *redshift.Redshift, timeout time.Duration, clusterIdentifier, scheduleIdentifier string) error {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{redshift.ScheduleStateModifying, redshift.ScheduleStateActive},
 		Target:     []string{"destroyed"},
-		Refresh:    resourceSnapshotScheduleAssociationStateRefreshFunc(clusterIdentifier, scheduleIdentifier, conn),
+		Refresh:    resourceSnapshotScheduleAssociationStateRefreshFunc(ctx, clusterIdentifier, scheduleIdentifier, conn),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Redshift Cluster (%s) and  Snapshot Schedule (%s) Association state to be \"destroyed\": %s", clusterIdentifier, scheduleIdentifier, err)
 	}
 
@@ -0,0 +1,82 @@
+package redshift
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceSnapshotSchedule() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSnapshotScheduleRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definitions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      snapshotScheduleDefinitionHash,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceSnapshotScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	identifier := d.Get("identifier").(string)
+
+	snapshotSchedule, err := FindSnapshotScheduleByID(ctx, conn, identifier)
+
+	if tfresource.NotFound(err) {
+		return diag.Errorf("no Redshift Snapshot Schedule found with identifier %q", identifier)
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Redshift Snapshot Schedule (%s): %s", identifier, err)
+	}
+
+	arn, err := meta.(*conns.AWSClient).RegionalARN(redshift.ServiceName, fmt.Sprintf("snapshotschedule:%s", aws.StringValue(snapshotSchedule.ScheduleIdentifier)))
+
+	if err != nil {
+		return diag.Errorf("error reading Redshift Snapshot Schedule (%s): %s", identifier, err)
+	}
+
+	d.SetId(aws.StringValue(snapshotSchedule.ScheduleIdentifier))
+	d.Set("arn", arn)
+	d.Set("description", snapshotSchedule.ScheduleDescription)
+	d.Set("identifier", snapshotSchedule.ScheduleIdentifier)
+
+	// Definitions are normalized the same way as by the resource,
+	// so that the outputs of both compare equal.
+	if err := d.Set("definitions", flattenSnapshotScheduleDefinitions(snapshotSchedule.ScheduleDefinitions)); err != nil {
+		return diag.Errorf("error setting definitions: %s", err)
+	}
+
+	if err := d.Set("tags", KeyValueTags(snapshotSchedule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
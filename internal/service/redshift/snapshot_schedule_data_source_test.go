@@ -0,0 +1,87 @@
+package redshift_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRedshiftSnapshotScheduleDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_redshift_snapshot_schedule.test"
+	resourceName := "aws_redshift_snapshot_schedule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identifier", resourceName, "identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "definitions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "definitions.*", "cron(30 12 *)"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "definitions.*", "rate(12 hours)"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "definitions.#", resourceName, "definitions.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Name", resourceName, "tags.Name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotScheduleDataSource_notFound(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSnapshotScheduleDataSourceNotFoundConfig(rName),
+				ExpectError: regexp.MustCompile(`no Redshift Snapshot Schedule found`),
+			},
+		},
+	})
+}
+
+func testAccSnapshotScheduleDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "test" {
+  identifier  = %[1]q
+  description = "test description"
+
+  # Extra whitespace is collapsed by both the resource and the data source.
+  definitions = [
+    "cron(30  12 *)",
+    "rate(12 hours)",
+  ]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_redshift_snapshot_schedule" "test" {
+  identifier = aws_redshift_snapshot_schedule.test.id
+}
+`, rName)
+}
+
+func testAccSnapshotScheduleDataSourceNotFoundConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_redshift_snapshot_schedule" "test" {
+  identifier = %[1]q
+}
+`, rName)
+}
@@ -1,215 +1,864 @@
 package redshift_test
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
-func TestAccRedshiftSnapshotSchedule_basic(t *testing.T) {
-	var v redshift.SnapshotSchedule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_redshift_snapshot_schedule.default"
+func TestSnapshotScheduleDefinitionsMaxItems(t *testing.T) {
+	r := tfredshift.ResourceSnapshotSchedule()
+	maxItems := r.Schema["definitions"].MaxItems
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
-		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccSnapshotScheduleConfig(rName, "rate(12 hours)"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSnapshotScheduleExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "definitions.#", "1"),
-				),
-			},
-			{
-				Config: testAccSnapshotScheduleConfig(rName, "cron(30 12 *)"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSnapshotScheduleExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "definitions.#", "1"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"force_destroy",
-				},
-			},
+	testCases := []struct {
+		Name        string
+		Count       int
+		ExpectError bool
+	}{
+		{
+			Name:  "at limit",
+			Count: maxItems,
 		},
-	})
+		{
+			Name:        "over limit",
+			Count:       maxItems + 1,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			definitions := make([]interface{}, testCase.Count)
+			for i := range definitions {
+				definitions[i] = fmt.Sprintf("rate(%d hours)", i+1)
+			}
+
+			diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+				"definitions": definitions,
+			}))
+
+			if got, want := diags.HasError(), testCase.ExpectError; got != want {
+				t.Errorf("got error %t, expected %t: %v", got, want, diags)
+			}
+		})
+	}
 }
 
-func TestAccRedshiftSnapshotSchedule_withMultipleDefinition(t *testing.T) {
-	var v redshift.SnapshotSchedule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_redshift_snapshot_schedule.default"
+func TestSnapshotScheduleDefinitionsNotEmpty(t *testing.T) {
+	r := tfredshift.ResourceSnapshotSchedule()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
-		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccSnapshotScheduleWithMultipleDefinitionConfig(rName, "cron(30 12 *)", "cron(15 6 *)"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSnapshotScheduleExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "definitions.#", "2"),
-				),
-			},
-			{
-				Config: testAccSnapshotScheduleWithMultipleDefinitionConfig(rName, "cron(30 8 *)", "cron(15 10 *)"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSnapshotScheduleExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "definitions.#", "2"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"force_destroy",
-				},
-			},
+	testCases := []struct {
+		Name        string
+		Definitions []interface{}
+		ExpectError bool
+	}{
+		{
+			Name:        "valid",
+			Definitions: []interface{}{"rate(12 hours)"},
+		},
+		{
+			Name:        "empty string",
+			Definitions: []interface{}{""},
+			ExpectError: true,
 		},
+		{
+			Name:        "whitespace only",
+			Definitions: []interface{}{"rate(12 hours)", "  "},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+				"definitions": testCase.Definitions,
+			}))
+
+			if got, want := diags.HasError(), testCase.ExpectError; got != want {
+				t.Errorf("got error %t, expected %t: %v", got, want, diags)
+			}
+		})
+	}
+}
+
+func TestDisassociateSnapshotScheduleClusters(t *testing.T) {
+	var mu sync.Mutex
+	disassociated := make(map[string]bool)
+
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		clusterIdentifier := aws.StringValue(r.Params.(*redshift.ModifyClusterSnapshotScheduleInput).ClusterIdentifier)
+
+		if clusterIdentifier == "cluster-fail" {
+			r.Error = awserr.New(redshift.ErrCodeInvalidClusterSnapshotScheduleStateFault, "cluster is busy", nil)
+			return
+		}
+
+		mu.Lock()
+		disassociated[clusterIdentifier] = true
+		mu.Unlock()
 	})
 
+	err := tfredshift.DisassociateSnapshotScheduleClusters(context.Background(), conn, "test-schedule", []string{"cluster-1", "cluster-fail", "cluster-2", "cluster-3"})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	for _, want := range []string{"1 of 4", "cluster-fail", "test-schedule", "cluster is busy"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error %q to contain %q", err, want)
+		}
+	}
+
+	for _, clusterIdentifier := range []string{"cluster-1", "cluster-2", "cluster-3"} {
+		if !disassociated[clusterIdentifier] {
+			t.Errorf("expected cluster %s to be disassociated", clusterIdentifier)
+		}
+	}
 }
 
-func TestAccRedshiftSnapshotSchedule_withIdentifierPrefix(t *testing.T) {
-	var v redshift.SnapshotSchedule
-	resourceName := "aws_redshift_snapshot_schedule.default"
+func TestDisassociateSnapshotScheduleClusters_contextCanceled(t *testing.T) {
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		t.Errorf("unexpected operation after cancellation: %s", r.Operation.Name)
+	})
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
-		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccSnapshotScheduleWithIdentifierPrefixConfig,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSnapshotScheduleExists(resourceName, &v),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"identifier_prefix",
-					"force_destroy",
-				},
-			},
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := tfredshift.DisassociateSnapshotScheduleClusters(ctx, conn, "test-schedule", []string{"cluster-1", "cluster-2"})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	for _, want := range []string{"2 of 2", "cluster-1", "cluster-2", context.Canceled.Error()} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error %q to contain %q", err, want)
+		}
+	}
+}
+
+func TestSnapshotScheduleDefinitionsMinimumInterval(t *testing.T) {
+	r := tfredshift.ResourceSnapshotSchedule()
+
+	testCases := []struct {
+		Name        string
+		Definition  string
+		ExpectError bool
+	}{
+		{
+			Name:       "hourly",
+			Definition: "rate(1 hour)",
 		},
-	})
+		{
+			Name:       "minimum in minutes",
+			Definition: "rate(60 minutes)",
+		},
+		{
+			Name:       "daily",
+			Definition: "rate(1 day)",
+		},
+		{
+			Name:        "too frequent in minutes",
+			Definition:  "rate(30 minutes)",
+			ExpectError: true,
+		},
+		{
+			Name:        "too frequent single minute",
+			Definition:  "rate(1 minute)",
+			ExpectError: true,
+		},
+		{
+			Name:        "too frequent with extra whitespace",
+			Definition:  " rate(59  minutes) ",
+			ExpectError: true,
+		},
+		{
+			Name:        "zero",
+			Definition:  "rate(0 hours)",
+			ExpectError: true,
+		},
+		{
+			Name:       "cron left to the API",
+			Definition: "cron(0/15 * *)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+				"definitions": []interface{}{testCase.Definition},
+			}))
+
+			if got, want := diags.HasError(), testCase.ExpectError; got != want {
+				t.Errorf("got error %t, expected %t: %v", got, want, diags)
+			}
+		})
+	}
 }
 
-func TestAccRedshiftSnapshotSchedule_withDescription(t *testing.T) {
-	var v redshift.SnapshotSchedule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_redshift_snapshot_schedule.default"
+func TestModifySnapshotScheduleDefinitions(t *testing.T) {
+	var applied []*string
+	var alter bool
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
-		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccSnapshotScheduleWithDescriptionConfig(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSnapshotScheduleExists(resourceName, &v),
-					resource.TestCheckResourceAttr(
-						resourceName, "description", "Test Schedule"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"force_destroy",
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *redshift.ModifySnapshotScheduleOutput:
+			applied = r.Params.(*redshift.ModifySnapshotScheduleInput).ScheduleDefinitions
+
+			// Simulate Redshift silently replacing one of the requested definitions.
+			if alter {
+				applied = append([]*string{aws.String("rate(1 day)")}, applied[1:]...)
+			}
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+				{
+					ScheduleIdentifier:  aws.String("test-schedule"),
+					ScheduleDefinitions: applied,
 				},
-			},
-		},
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
 	})
+
+	err := tfredshift.ModifySnapshotScheduleDefinitions(context.Background(), conn, "test-schedule", aws.StringSlice([]string{"rate(12 hours)", "cron(30 12 *)"}))
+
+	if err != nil {
+		t.Fatalf("expected no error when requested definitions were applied, got: %s", err)
+	}
+
+	alter = true
+
+	err = tfredshift.ModifySnapshotScheduleDefinitions(context.Background(), conn, "test-schedule", aws.StringSlice([]string{"rate(12 hours)", "cron(30 12 *)"}))
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	for _, want := range []string{"test-schedule", "differ from requested", `missing ["rate(12 hours)"]`, `unexpected ["rate(1 day)"]`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error %q to contain %q", err, want)
+		}
+	}
 }
 
-func TestAccRedshiftSnapshotSchedule_withTags(t *testing.T) {
-	var v redshift.SnapshotSchedule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_redshift_snapshot_schedule.default"
+func TestSnapshotScheduleReadTags(t *testing.T) {
+	var listTagsErr error
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
-		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccSnapshotScheduleWithTagsConfig(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSnapshotScheduleExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
-					resource.TestCheckResourceAttr(resourceName, "tags.fizz", "buzz"),
-				),
-			},
-			{
-				Config: testAccSnapshotScheduleWithTagsUpdateConfig(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSnapshotScheduleExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.good", "bad"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"force_destroy",
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			// Simulate a describe payload that has not caught up with a tag update.
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+				{
+					ScheduleIdentifier:  aws.String("test-schedule"),
+					ScheduleDefinitions: aws.StringSlice([]string{"rate(12 hours)"}),
+					Tags: []*redshift.Tag{
+						{Key: aws.String("foo"), Value: aws.String("bar")},
+					},
 				},
-			},
-		},
+			}
+		case *redshift.DescribeTagsOutput:
+			if listTagsErr != nil {
+				r.Error = listTagsErr
+				return
+			}
+
+			if got, want := aws.StringValue(r.Params.(*redshift.DescribeTagsInput).ResourceName), "arn:aws:redshift:us-west-2:123456789012:snapshotschedule:test-schedule"; got != want {
+				t.Errorf("got DescribeTags ResourceName %s, expected %s", got, want)
+			}
+
+			data.TaggedResources = []*redshift.TaggedResource{
+				{Tag: &redshift.Tag{Key: aws.String("foo"), Value: aws.String("bar2")}},
+				{Tag: &redshift.Tag{Key: aws.String("good"), Value: aws.String("bad")}},
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
 	})
+
+	meta := &conns.AWSClient{
+		AccountID:    "123456789012",
+		Partition:    "aws",
+		Region:       "us-west-2",
+		RedshiftConn: conn,
+	}
+
+	testCases := []struct {
+		Name         string
+		ListTagsErr  error
+		ExpectedTags map[string]string
+	}{
+		{
+			Name:         "listed tags",
+			ExpectedTags: map[string]string{"foo": "bar2", "good": "bad"},
+		},
+		{
+			Name:         "fallback to described tags",
+			ListTagsErr:  awserr.New("AccessDenied", "not authorized to perform: redshift:DescribeTags", nil),
+			ExpectedTags: map[string]string{"foo": "bar"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			listTagsErr = testCase.ListTagsErr
+
+			r := tfredshift.ResourceSnapshotSchedule()
+			d := r.TestResourceData()
+			d.SetId("test-schedule")
+
+			if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			got := make(map[string]string)
+			for k, v := range d.Get("tags").(map[string]interface{}) {
+				got[k] = v.(string)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedTags) {
+				t.Errorf("got tags %v, expected %v", got, testCase.ExpectedTags)
+			}
+		})
+	}
 }
 
-func TestAccRedshiftSnapshotSchedule_withForceDestroy(t *testing.T) {
-	var snapshotSchedule redshift.SnapshotSchedule
-	var cluster redshift.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_redshift_snapshot_schedule.default"
-	clusterResourceName := "aws_redshift_cluster.test"
+func TestSnapshotScheduleUpdate_tagShadowingDefaultTag(t *testing.T) {
+	var mu sync.Mutex
+	// The tags on the schedule, as created with the user tag shadowing the
+	// default tag of the same value.
+	tags := map[string]string{"env": "prod", "owner": "me", "team": "a"}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
-		Providers:    acctest.Providers,
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+				{
+					ScheduleIdentifier:  aws.String("test-schedule"),
+					ScheduleDefinitions: aws.StringSlice([]string{"rate(12 hours)"}),
+				},
+			}
+		case *redshift.DescribeTagsOutput:
+			for k, v := range tags {
+				data.TaggedResources = append(data.TaggedResources, &redshift.TaggedResource{
+					Tag: &redshift.Tag{Key: aws.String(k), Value: aws.String(v)},
+				})
+			}
+		case *redshift.CreateTagsOutput:
+			for _, tag := range r.Params.(*redshift.CreateTagsInput).Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+		case *redshift.DeleteTagsOutput:
+			for _, k := range aws.StringValueSlice(r.Params.(*redshift.DeleteTagsInput).TagKeys) {
+				delete(tags, k)
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	newMeta := func(defaultTags map[string]interface{}) *conns.AWSClient {
+		return &conns.AWSClient{
+			AccountID:         "123456789012",
+			DefaultTagsConfig: &tftags.DefaultConfig{Tags: tftags.New(defaultTags)},
+			IgnoreTagsConfig:  &tftags.IgnoreConfig{},
+			Partition:         "aws",
+			Region:            "us-west-2",
+			RedshiftConn:      conn,
+		}
+	}
+
+	r := tfredshift.ResourceSnapshotSchedule()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"identifier":  "test-schedule",
+		"definitions": []interface{}{"rate(12 hours)"},
+		"tags": map[string]interface{}{
+			"env":   "prod",
+			"owner": "me",
+		},
+	})
+
+	// Refresh with the default tags the schedule was created with.
+	meta := newMeta(map[string]interface{}{"env": "prod", "team": "a"})
+	d := r.TestResourceData()
+	d.SetId("test-schedule")
+	d.Set("identifier", "test-schedule")
+	d.Set("definitions", []interface{}{"rate(12 hours)"})
+	d.Set("force_destroy", false)
+	d.Set("preserve_associations_on_rename", false)
+	d.Set("validate_only", false)
+	d.Set("tags", map[string]interface{}{"env": "prod", "owner": "me"})
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	state := d.State()
+
+	if diff, err := r.Diff(context.Background(), state, config, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff for a user tag shadowing a default tag, got: %#v", diff.Attributes)
+	}
+
+	// Both default tags change in the same apply, the shadowed one to a
+	// value that no longer matches the user tag.
+	meta = newMeta(map[string]interface{}{"env": "staging", "team": "b"})
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if expected := map[string]string{"env": "prod", "owner": "me", "team": "b"}; !reflect.DeepEqual(tags, expected) {
+		t.Errorf("got schedule tags %v, expected %v", tags, expected)
+	}
+
+	for k, expected := range map[string]string{
+		"tags.%":         "2",
+		"tags.env":       "prod",
+		"tags.owner":     "me",
+		"tags_all.%":     "3",
+		"tags_all.env":   "prod",
+		"tags_all.owner": "me",
+		"tags_all.team":  "b",
+	} {
+		if got := newState.Attributes[k]; got != expected {
+			t.Errorf("got %s %q, expected %q", k, got, expected)
+		}
+	}
+
+	if diff, err := r.Diff(context.Background(), newState, config, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff after update, got: %#v", diff.Attributes)
+	}
+}
+
+func TestSnapshotScheduleDelete_contextCanceled(t *testing.T) {
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			// The association never leaves the active state, so the force destroy
+			// wait only ends when its context is canceled.
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+				{
+					ScheduleIdentifier: aws.String("test-schedule"),
+					AssociatedClusters: []*redshift.ClusterAssociatedToSchedule{
+						{
+							ClusterIdentifier:        aws.String("cluster-1"),
+							ScheduleAssociationState: aws.String(redshift.ScheduleStateActive),
+						},
+					},
+				},
+			}
+		case *redshift.ModifyClusterSnapshotScheduleOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	r := tfredshift.ResourceSnapshotSchedule()
+	d := r.TestResourceData()
+	d.SetId("test-schedule")
+	d.Set("force_destroy", true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	diags := r.DeleteContext(ctx, d, &conns.AWSClient{RedshiftConn: conn})
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("delete took %s after context cancellation, expected it to return promptly", elapsed)
+	}
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestSnapshotScheduleDelete_disassociateFailed(t *testing.T) {
+	var mu sync.Mutex
+	associated := map[string]bool{"cluster-1": true, "cluster-fail": true}
+	failDisassociation := true
+	var deleted bool
+
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			snapshotSchedule := &redshift.SnapshotSchedule{
+				ScheduleIdentifier: aws.String("test-schedule"),
+			}
+
+			for _, clusterIdentifier := range []string{"cluster-1", "cluster-fail"} {
+				if associated[clusterIdentifier] {
+					snapshotSchedule.AssociatedClusters = append(snapshotSchedule.AssociatedClusters, &redshift.ClusterAssociatedToSchedule{
+						ClusterIdentifier:        aws.String(clusterIdentifier),
+						ScheduleAssociationState: aws.String(redshift.ScheduleStateActive),
+					})
+				}
+			}
+
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{snapshotSchedule}
+		case *redshift.ModifyClusterSnapshotScheduleOutput:
+			clusterIdentifier := aws.StringValue(r.Params.(*redshift.ModifyClusterSnapshotScheduleInput).ClusterIdentifier)
+
+			if clusterIdentifier == "cluster-fail" && failDisassociation {
+				r.Error = awserr.New(redshift.ErrCodeInvalidClusterSnapshotScheduleStateFault, "cluster is busy", nil)
+				return
+			}
+
+			associated[clusterIdentifier] = false
+		case *redshift.DeleteSnapshotScheduleOutput:
+			deleted = true
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{RedshiftConn: conn}
+	r := tfredshift.ResourceSnapshotSchedule()
+	state := &terraform.InstanceState{
+		ID: "test-schedule",
+		Attributes: map[string]string{
+			"id":            "test-schedule",
+			"force_destroy": "true",
+			"identifier":    "test-schedule",
+		},
+	}
+	destroy := &terraform.InstanceDiff{Destroy: true}
+
+	newState, diags := r.Apply(context.Background(), state, destroy, meta)
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	if deleted {
+		t.Error("expected the snapshot schedule not to be deleted while a cluster is still associated")
+	}
+
+	if newState == nil || newState.ID != "test-schedule" {
+		t.Fatalf("expected the snapshot schedule to remain in state, got: %#v", newState)
+	}
+
+	if got := newState.Attributes["force_destroy"]; got != "true" {
+		t.Errorf("got force_destroy %q in state, expected true", got)
+	}
+
+	// Retrying the destroy completes once the cluster can be disassociated.
+	mu.Lock()
+	failDisassociation = false
+	mu.Unlock()
+
+	newState, diags = r.Apply(context.Background(), newState, destroy, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !deleted {
+		t.Error("expected the snapshot schedule to be deleted")
+	}
+
+	if newState != nil {
+		t.Errorf("expected the snapshot schedule to be removed from state, got: %#v", newState)
+	}
+}
+
+func TestSnapshotScheduleDelete_partiallyDisassociated(t *testing.T) {
+	var mu sync.Mutex
+	// cluster-1 is still associated, cluster-2 is being disassociated by a
+	// previous attempt and cluster-3 was already disassociated.
+	associationStates := map[string]string{
+		"cluster-1": redshift.ScheduleStateActive,
+		"cluster-2": redshift.ScheduleStateModifying,
+	}
+	var modified []string
+	var deleted bool
+
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			input := r.Params.(*redshift.DescribeSnapshotSchedulesInput)
+			snapshotSchedule := &redshift.SnapshotSchedule{
+				ScheduleIdentifier: aws.String("test-schedule"),
+			}
+
+			for _, clusterIdentifier := range []string{"cluster-1", "cluster-2", "cluster-3"} {
+				state, ok := associationStates[clusterIdentifier]
+
+				if !ok {
+					continue
+				}
+
+				if v := aws.StringValue(input.ClusterIdentifier); v != "" {
+					if v != clusterIdentifier {
+						continue
+					}
+
+					// The in-flight disassociation completes while waiting.
+					if state == redshift.ScheduleStateModifying {
+						delete(associationStates, clusterIdentifier)
+						continue
+					}
+				}
+
+				snapshotSchedule.AssociatedClusters = append(snapshotSchedule.AssociatedClusters, &redshift.ClusterAssociatedToSchedule{
+					ClusterIdentifier:        aws.String(clusterIdentifier),
+					ScheduleAssociationState: aws.String(state),
+				})
+			}
+
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{snapshotSchedule}
+		case *redshift.ModifyClusterSnapshotScheduleOutput:
+			clusterIdentifier := aws.StringValue(r.Params.(*redshift.ModifyClusterSnapshotScheduleInput).ClusterIdentifier)
+			modified = append(modified, clusterIdentifier)
+
+			if associationStates[clusterIdentifier] != redshift.ScheduleStateActive {
+				r.Error = awserr.New(redshift.ErrCodeInvalidClusterSnapshotScheduleStateFault, "cluster is not associated or is being modified", nil)
+				return
+			}
+
+			delete(associationStates, clusterIdentifier)
+		case *redshift.DeleteSnapshotScheduleOutput:
+			deleted = true
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{RedshiftConn: conn}
+	r := tfredshift.ResourceSnapshotSchedule()
+	state := &terraform.InstanceState{
+		ID: "test-schedule",
+		Attributes: map[string]string{
+			"id":            "test-schedule",
+			"force_destroy": "true",
+			"identifier":    "test-schedule",
+		},
+	}
+
+	if _, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if expected := []string{"cluster-1"}; !reflect.DeepEqual(modified, expected) {
+		t.Errorf("got ModifyClusterSnapshotSchedule calls for %q, expected %q", modified, expected)
+	}
+
+	if len(associationStates) != 0 {
+		t.Errorf("expected all clusters to be disassociated, got %v", associationStates)
+	}
+
+	if !deleted {
+		t.Error("expected the snapshot schedule to be deleted")
+	}
+}
+
+func TestSnapshotScheduleDelete_associating(t *testing.T) {
+	var mu sync.Mutex
+	// cluster-1 is being associated with the schedule when it is destroyed.
+	associationStates := map[string]string{
+		"cluster-1": redshift.ScheduleStateModifying,
+	}
+	var modified []string
+	var deleted bool
+
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			input := r.Params.(*redshift.DescribeSnapshotSchedulesInput)
+			snapshotSchedule := &redshift.SnapshotSchedule{
+				ScheduleIdentifier: aws.String("test-schedule"),
+			}
+
+			for clusterIdentifier, state := range associationStates {
+				// The in-flight association completes while waiting.
+				if aws.StringValue(input.ClusterIdentifier) == clusterIdentifier && state == redshift.ScheduleStateModifying {
+					state = redshift.ScheduleStateActive
+					associationStates[clusterIdentifier] = state
+				}
+
+				snapshotSchedule.AssociatedClusters = append(snapshotSchedule.AssociatedClusters, &redshift.ClusterAssociatedToSchedule{
+					ClusterIdentifier:        aws.String(clusterIdentifier),
+					ScheduleAssociationState: aws.String(state),
+				})
+			}
+
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{snapshotSchedule}
+		case *redshift.ModifyClusterSnapshotScheduleOutput:
+			clusterIdentifier := aws.StringValue(r.Params.(*redshift.ModifyClusterSnapshotScheduleInput).ClusterIdentifier)
+			modified = append(modified, clusterIdentifier)
+
+			if associationStates[clusterIdentifier] != redshift.ScheduleStateActive {
+				r.Error = awserr.New(redshift.ErrCodeInvalidClusterSnapshotScheduleStateFault, "cluster is not associated or is being modified", nil)
+				return
+			}
+
+			delete(associationStates, clusterIdentifier)
+		case *redshift.DeleteSnapshotScheduleOutput:
+			deleted = true
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{RedshiftConn: conn}
+	r := tfredshift.ResourceSnapshotSchedule()
+	state := &terraform.InstanceState{
+		ID: "test-schedule",
+		Attributes: map[string]string{
+			"id":            "test-schedule",
+			"force_destroy": "true",
+			"identifier":    "test-schedule",
+		},
+	}
+
+	if _, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if expected := []string{"cluster-1"}; !reflect.DeepEqual(modified, expected) {
+		t.Errorf("got ModifyClusterSnapshotSchedule calls for %q, expected %q", modified, expected)
+	}
+
+	if len(associationStates) != 0 {
+		t.Errorf("expected all clusters to be disassociated, got %v", associationStates)
+	}
+
+	if !deleted {
+		t.Error("expected the snapshot schedule to be deleted")
+	}
+}
+
+func TestSnapshotScheduleImport(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ID            string
+		ExpectedID    string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:       "identifier",
+			ID:         "test-schedule",
+			ExpectedID: "test-schedule",
+		},
+		{
+			Name:       "ARN",
+			ID:         "arn:aws:redshift:us-west-2:123456789012:snapshotschedule:test-schedule",
+			ExpectedID: "test-schedule",
+		},
+		{
+			Name:          "wrong service",
+			ID:            "arn:aws:rds:us-west-2:123456789012:snapshotschedule:test-schedule",
+			ExpectedError: regexp.MustCompile(`is for service "rds"`),
+		},
+		{
+			Name:          "wrong resource type",
+			ID:            "arn:aws:redshift:us-west-2:123456789012:cluster:test-cluster",
+			ExpectedError: regexp.MustCompile(`is not a Redshift Snapshot Schedule ARN`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := tfredshift.ResourceSnapshotSchedule()
+			d := r.TestResourceData()
+			d.SetId(testCase.ID)
+
+			_, err := r.Importer.StateContext(context.Background(), d, nil)
+
+			if testCase.ExpectedError != nil {
+				if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Id(); got != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
+
+func TestAccRedshiftSnapshotSchedule_basic(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
 		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSnapshotScheduleWithForceDestroyConfig(rName),
+				Config: testAccSnapshotScheduleConfig(rName, "rate(12 hours)"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSnapshotScheduleExists(resourceName, &snapshotSchedule),
-					testAccCheckClusterExists(clusterResourceName, &cluster),
-					testAccCheckSnapshotScheduleCreateSnapshotScheduleAssociation(&cluster, &snapshotSchedule),
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definitions.#", "1"),
+				),
+			},
+			{
+				Config: testAccSnapshotScheduleConfig(rName, "cron(30 12 *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definitions.#", "1"),
 				),
 			},
 			{
@@ -220,173 +869,1521 @@ func TestAccRedshiftSnapshotSchedule_withForceDestroy(t *testing.T) {
 					"force_destroy",
 				},
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSnapshotScheduleARNImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_destroy",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_definitionsHash(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	var definitionsHash string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleConfig(rName, "rate(12 hours)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "definitions_hash", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					testAccCheckSnapshotScheduleDefinitionsHash(resourceName, &definitionsHash, false),
+				),
+			},
+			{
+				// A whitespace-only edit keeps the hash.
+				Config: testAccSnapshotScheduleConfig(rName, " rate(12  hours)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttrPtr(resourceName, "definitions_hash", &definitionsHash),
+				),
+			},
+			{
+				Config: testAccSnapshotScheduleConfig(rName, "rate(6 hours)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					testAccCheckSnapshotScheduleDefinitionsHash(resourceName, &definitionsHash, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_orderedDefinitions(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleWithOrderedDefinitionsConfig(rName, "rate(12 hours)", "cron(30 12 *)", "rate(1 day)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					testAccCheckSnapshotScheduleDefinitions(&v, []string{"rate(12 hours)", "cron(30 12 *)", "rate(1 day)"}),
+					resource.TestCheckResourceAttr(resourceName, "definitions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_definitions.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_definitions.0", "rate(12 hours)"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_definitions.1", "cron(30 12 *)"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_definitions.2", "rate(1 day)"),
+				),
+			},
+			{
+				// Refreshing keeps the configured order, so there is no diff.
+				Config:   testAccSnapshotScheduleWithOrderedDefinitionsConfig(rName, "rate(12 hours)", "cron(30 12 *)", "rate(1 day)"),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccSnapshotScheduleWithOrderedDefinitionsConfig(rName, "rate(12 hours)", "cron(30 12 *)", "rate(12  hours)"),
+				ExpectError: regexp.MustCompile(`ordered_definitions repeats rate\(12 hours\)`),
+			},
 		},
 	})
-}
+}
+
+func TestAccRedshiftSnapshotSchedule_withMultipleDefinition(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleWithMultipleDefinitionConfig(rName, "cron(30 12 *)", "cron(15 6 *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definitions.#", "2"),
+				),
+			},
+			{
+				Config: testAccSnapshotScheduleWithMultipleDefinitionConfig(rName, "cron(30 8 *)", "cron(15 10 *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definitions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_destroy",
+				},
+			},
+		},
+	})
+
+}
+
+func TestAccRedshiftSnapshotSchedule_withIdentifierPrefix(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleWithIdentifierPrefixConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"identifier_prefix",
+					"force_destroy",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_withoutIdentifier(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleWithoutIdentifierConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "identifier", regexp.MustCompile(fmt.Sprintf("^%s", resource.UniqueIdPrefix))),
+				),
+			},
+			{
+				Config:   testAccSnapshotScheduleWithoutIdentifierConfig,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_identifierAndIdentifierPrefix(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSnapshotScheduleWithIdentifierAndIdentifierPrefixConfig,
+				ExpectError: regexp.MustCompile("only one of `identifier` or `identifier_prefix` can be set"),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_withInterval(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleWithIntervalConfig(rName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definitions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "definitions.*", "cron(30 12 *)"),
+					resource.TestCheckResourceAttr(resourceName, "interval.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "interval.0.unit", "hours"),
+					resource.TestCheckResourceAttr(resourceName, "interval.0.value", "6"),
+					testAccCheckSnapshotScheduleDefinitions(&v, []string{"cron(30 12 *)", "rate(6 hours)"}),
+				),
+			},
+			{
+				Config: testAccSnapshotScheduleWithIntervalConfig(rName, 12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definitions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "interval.0.value", "12"),
+					testAccCheckSnapshotScheduleDefinitions(&v, []string{"cron(30 12 *)", "rate(12 hours)"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_withDescription(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleWithDescriptionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(
+						resourceName, "description", "Test Schedule"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_destroy",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_withTags(t *testing.T) {
+	var v redshift.SnapshotSchedule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleWithTagsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "tags.fizz", "buzz"),
+				),
+			},
+			{
+				Config: testAccSnapshotScheduleWithTagsUpdateConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.good", "bad"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_destroy",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_DefaultTags_shadowedTag(t *testing.T) {
+	var providers []*schema.Provider
+	var v redshift.SnapshotSchedule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("env", "prod", "team", "a"),
+					testAccSnapshotScheduleTags2Config(rName, "env", "prod", "owner", "me"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "me"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.team", "a"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("env", "staging", "team", "b"),
+					testAccSnapshotScheduleTags2Config(rName, "env", "prod", "owner", "me"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "me"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.env", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "me"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.team", "b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_withForceDestroy(t *testing.T) {
+	var snapshotSchedule redshift.SnapshotSchedule
+	var cluster redshift.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+	clusterResourceName := "aws_redshift_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleWithForceDestroyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &snapshotSchedule),
+					testAccCheckClusterExists(clusterResourceName, &cluster),
+					testAccCheckSnapshotScheduleCreateSnapshotScheduleAssociation(&cluster, &snapshotSchedule),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_destroy",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_withForceDestroyMultipleClusters(t *testing.T) {
+	var snapshotSchedule redshift.SnapshotSchedule
+	var cluster1, cluster2 redshift.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+	cluster1ResourceName := "aws_redshift_cluster.test.0"
+	cluster2ResourceName := "aws_redshift_cluster.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleWithForceDestroyMultipleClustersConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &snapshotSchedule),
+					testAccCheckClusterExists(cluster1ResourceName, &cluster1),
+					testAccCheckClusterExists(cluster2ResourceName, &cluster2),
+					testAccCheckSnapshotScheduleCreateSnapshotScheduleAssociation(&cluster1, &snapshotSchedule),
+					testAccCheckSnapshotScheduleCreateSnapshotScheduleAssociation(&cluster2, &snapshotSchedule),
+				),
+			},
+			{
+				Config: testAccSnapshotScheduleWithForceDestroyMultipleClustersConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleClusterDisassociated(&cluster1),
+					testAccCheckSnapshotScheduleClusterDisassociated(&cluster2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_preserveAssociationsOnRename(t *testing.T) {
+	var snapshotSchedule redshift.SnapshotSchedule
+	var cluster redshift.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := rName + "-renamed"
+	resourceName := "aws_redshift_snapshot_schedule.default"
+	clusterResourceName := "aws_redshift_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleWithPreserveAssociationsOnRenameConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &snapshotSchedule),
+					testAccCheckClusterExists(clusterResourceName, &cluster),
+					testAccCheckSnapshotScheduleCreateSnapshotScheduleAssociation(&cluster, &snapshotSchedule),
+					resource.TestCheckResourceAttr(resourceName, "preserve_associations_on_rename", "true"),
+				),
+			},
+			{
+				Config: testAccSnapshotScheduleWithPreserveAssociationsOnRenameConfig(rName, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotScheduleExists(resourceName, &snapshotSchedule),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "id", rNameUpdated),
+					testAccCheckSnapshotScheduleNotExists(rName),
+					testAccCheckSnapshotScheduleClusterAssociated(&cluster, rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotSchedule_validateOnly(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_snapshot_schedule.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotScheduleValidateOnlyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "validate_only", "true"),
+					testAccCheckSnapshotScheduleNotExists(rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSnapshotScheduleNotExists(identifier string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
+			ScheduleIdentifier: aws.String(identifier),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, s := range resp.SnapshotSchedules {
+			if aws.StringValue(s.ScheduleIdentifier) == identifier {
+				return fmt.Errorf("Redshift Snapshot Schedule (%s) exists", identifier)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSnapshotScheduleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_snapshot_schedule" {
+			continue
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+		resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
+			ScheduleIdentifier: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if len(resp.SnapshotSchedules) != 0 {
+				for _, s := range resp.SnapshotSchedules {
+					if *s.ScheduleIdentifier == rs.Primary.ID {
+						return fmt.Errorf("Redshift Cluster Snapshot Schedule %s still exists", rs.Primary.ID)
+					}
+				}
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func testAccSnapshotScheduleARNImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
+	}
+}
+
+func testAccCheckSnapshotScheduleExists(n string, v *redshift.SnapshotSchedule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Cluster Snapshot Schedule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+		resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
+			ScheduleIdentifier: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, s := range resp.SnapshotSchedules {
+			if *s.ScheduleIdentifier == rs.Primary.ID {
+				*v = *s
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Redshift Snapshot Schedule (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckSnapshotScheduleDefinitions(v *redshift.SnapshotSchedule, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		got := flex.FlattenStringSet(v.ScheduleDefinitions)
+		want := flex.FlattenStringSet(aws.StringSlice(expected))
+
+		if !got.Equal(want) {
+			return fmt.Errorf("Redshift Snapshot Schedule definitions: got %q, expected %q", aws.StringValueSlice(v.ScheduleDefinitions), expected)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckSnapshotScheduleDefinitionsHash records the definitions_hash of a
+// snapshot schedule, failing if it did not change from the recorded one when expected to.
+func testAccCheckSnapshotScheduleDefinitionsHash(n string, definitionsHash *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		got := rs.Primary.Attributes["definitions_hash"]
+
+		if changed && got == *definitionsHash {
+			return fmt.Errorf("expected definitions_hash to change from %s", *definitionsHash)
+		}
+
+		*definitionsHash = got
+
+		return nil
+	}
+}
+
+func testAccCheckSnapshotScheduleCreateSnapshotScheduleAssociation(cluster *redshift.Cluster, snapshotSchedule *redshift.SnapshotSchedule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		if _, err := conn.ModifyClusterSnapshotSchedule(&redshift.ModifyClusterSnapshotScheduleInput{
+			ClusterIdentifier:    cluster.ClusterIdentifier,
+			ScheduleIdentifier:   snapshotSchedule.ScheduleIdentifier,
+			DisassociateSchedule: aws.Bool(false),
+		}); err != nil {
+			return fmt.Errorf("Error associate Redshift Cluster and Snapshot Schedule: %s", err)
+		}
+
+		if err := tfredshift.WaitForSnapshotScheduleAssociationActive(conn, 75*time.Minute, aws.StringValue(cluster.ClusterIdentifier), aws.StringValue(snapshotSchedule.ScheduleIdentifier)); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSnapshotScheduleClusterAssociated(cluster *redshift.Cluster, scheduleIdentifier string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		output, err := tfredshift.FindClusterByID(conn, aws.StringValue(cluster.ClusterIdentifier))
+
+		if err != nil {
+			return err
+		}
+
+		if v := aws.StringValue(output.SnapshotScheduleIdentifier); v != scheduleIdentifier {
+			return fmt.Errorf("Redshift Cluster (%s) associated with Snapshot Schedule (%s), expected (%s)", aws.StringValue(cluster.ClusterIdentifier), v, scheduleIdentifier)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSnapshotScheduleClusterDisassociated(cluster *redshift.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		output, err := tfredshift.FindClusterByID(conn, aws.StringValue(cluster.ClusterIdentifier))
+
+		if err != nil {
+			return err
+		}
+
+		if v := aws.StringValue(output.SnapshotScheduleIdentifier); v != "" {
+			return fmt.Errorf("Redshift Cluster (%s) still associated with Snapshot Schedule (%s)", aws.StringValue(cluster.ClusterIdentifier), v)
+		}
+
+		return nil
+	}
+}
+
+const testAccSnapshotScheduleWithIdentifierPrefixConfig = `
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier_prefix = "tf-acc-test"
+  definitions = [
+    "rate(12 hours)",
+  ]
+}
+`
+
+const testAccSnapshotScheduleWithoutIdentifierConfig = `
+resource "aws_redshift_snapshot_schedule" "default" {
+  definitions = [
+    "rate(12 hours)",
+  ]
+}
+`
+
+const testAccSnapshotScheduleWithIdentifierAndIdentifierPrefixConfig = `
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier        = "tf-acc-test"
+  identifier_prefix = "tf-acc-test"
+  definitions = [
+    "rate(12 hours)",
+  ]
+}
+`
+
+func testAccSnapshotScheduleConfig(rName, definition string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier = %[1]q
+  definitions = [
+    "%[2]s",
+  ]
+}
+`, rName, definition)
+}
+
+func testAccSnapshotScheduleWithIntervalConfig(rName string, hours int) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier = %[1]q
+  definitions = [
+    "cron(30 12 *)",
+  ]
+
+  interval {
+    value = %[2]d
+    unit  = "hours"
+  }
+}
+`, rName, hours)
+}
+
+func testAccSnapshotScheduleValidateOnlyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier = %[1]q
+  definitions = [
+    "rate(12 hours)",
+  ]
+  validate_only = true
+}
+`, rName)
+}
+
+func testAccSnapshotScheduleWithMultipleDefinitionConfig(rName, definition1, definition2 string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier = %[1]q
+  definitions = [
+    "%[2]s",
+    "%[3]s",
+  ]
+}
+`, rName, definition1, definition2)
+}
+
+func testAccSnapshotScheduleWithOrderedDefinitionsConfig(rName, definition1, definition2, definition3 string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier = %[1]q
+  ordered_definitions = [
+    "%[2]s",
+    "%[3]s",
+    "%[4]s",
+  ]
+}
+`, rName, definition1, definition2, definition3)
+}
+
+func testAccSnapshotScheduleWithDescriptionConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier  = %[1]q
+  description = "Test Schedule"
+  definitions = [
+    "rate(12 hours)",
+  ]
+}
+`, rName)
+}
+
+func testAccSnapshotScheduleWithTagsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier = %[1]q
+  definitions = [
+    "rate(12 hours)",
+  ]
+
+  tags = {
+    foo  = "bar"
+    fizz = "buzz"
+  }
+}
+`, rName)
+}
+
+func testAccSnapshotScheduleTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier = %[1]q
+  definitions = [
+    "rate(12 hours)",
+  ]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccSnapshotScheduleWithTagsUpdateConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier = %[1]q
+  definitions = [
+    "rate(12 hours)",
+  ]
+
+  tags = {
+    foo  = "bar2"
+    good = "bad"
+  }
+}
+`, rName)
+}
+
+func testAccSnapshotScheduleWithForceDestroyConfig(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier  = %[1]q
+  description = "Test Schedule"
+  definitions = [
+    "rate(12 hours)",
+  ]
+  force_destroy = true
+}
+`, rName))
+}
+
+func testAccSnapshotScheduleWithPreserveAssociationsOnRenameConfig(rName, identifier string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier  = %[1]q
+  description = "Test Schedule"
+  definitions = [
+    "rate(12 hours)",
+  ]
+  force_destroy                   = true
+  preserve_associations_on_rename = true
+}
+`, identifier))
+}
+
+func testAccSnapshotScheduleWithForceDestroyMultipleClustersConfig(rName string, withSchedule bool) string {
+	config := acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  count = 2
+
+  cluster_identifier                  = "%[1]s-${count.index}"
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc2.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+`, rName))
+
+	if !withSchedule {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "default" {
+  identifier  = %[1]q
+  description = "Test Schedule"
+  definitions = [
+    "rate(12 hours)",
+  ]
+  force_destroy = true
+}
+`, rName))
+}
+
+func TestSnapshotScheduleCreate_validateOnlyStableID(t *testing.T) {
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		switch r.Data.(type) {
+		case *redshift.CreateSnapshotScheduleOutput:
+			if input := r.Params.(*redshift.CreateSnapshotScheduleInput); !aws.BoolValue(input.DryRun) {
+				t.Errorf("expected a dry run CreateSnapshotSchedule request")
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID:    "123456789012",
+		Partition:    "aws",
+		Region:       "us-west-2",
+		RedshiftConn: conn,
+	}
+
+	testCases := []struct {
+		Name           string
+		Config         map[string]interface{}
+		ExpectedPrefix string
+	}{
+		{
+			Name: "without identifier",
+			Config: map[string]interface{}{
+				"definitions":   []interface{}{"rate(12 hours)"},
+				"validate_only": true,
+			},
+			ExpectedPrefix: resource.UniqueIdPrefix,
+		},
+		{
+			Name: "identifier prefix",
+			Config: map[string]interface{}{
+				"definitions":       []interface{}{"rate(12 hours)"},
+				"identifier_prefix": "test-",
+				"validate_only":     true,
+			},
+			ExpectedPrefix: "test-",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var ids []string
+
+			for i := 0; i < 2; i++ {
+				r := tfredshift.ResourceSnapshotSchedule()
+				d := schema.TestResourceDataRaw(t, r.Schema, testCase.Config)
+
+				if diags := r.CreateContext(context.Background(), d, meta); diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+
+				ids = append(ids, d.Id())
+			}
+
+			if ids[0] != ids[1] {
+				t.Errorf("got IDs %q and %q, expected the same ID for both applies", ids[0], ids[1])
+			}
+
+			if !strings.HasPrefix(ids[0], testCase.ExpectedPrefix) {
+				t.Errorf("got ID %q, expected prefix %q", ids[0], testCase.ExpectedPrefix)
+			}
+		})
+	}
+}
+
+func TestSnapshotScheduleRead_throttling(t *testing.T) {
+	var describeCalls int
+
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			describeCalls++
+
+			if describeCalls == 1 {
+				r.Error = awserr.New("Throttling", "Rate exceeded", nil)
+				return
+			}
+
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+				{
+					ScheduleIdentifier:  aws.String("test-schedule"),
+					ScheduleDescription: aws.String("test description"),
+					ScheduleDefinitions: aws.StringSlice([]string{"rate(12 hours)"}),
+				},
+			}
+		case *redshift.DescribeTagsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID:    "123456789012",
+		Partition:    "aws",
+		Region:       "us-west-2",
+		RedshiftConn: conn,
+	}
+
+	r := tfredshift.ResourceSnapshotSchedule()
+	d := r.TestResourceData()
+	d.SetId("test-schedule")
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if describeCalls != 2 {
+		t.Errorf("got %d DescribeSnapshotSchedules calls, expected 2", describeCalls)
+	}
+
+	if d.Id() != "test-schedule" {
+		t.Errorf("got ID %q, expected schedule to remain in state", d.Id())
+	}
+
+	if got, want := d.Get("description").(string), "test description"; got != want {
+		t.Errorf("got description %q, expected %q", got, want)
+	}
+}
+
+func TestSnapshotScheduleRead_delayedVisibility(t *testing.T) {
+	var describeCalls int
+
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			describeCalls++
+
+			switch describeCalls {
+			case 1:
+				// Not yet visible: an empty result.
+				return
+			case 2:
+				r.Error = awserr.New(redshift.ErrCodeSnapshotScheduleNotFoundFault, "not found", nil)
+				return
+			}
+
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+				{
+					ScheduleIdentifier:  aws.String("test-schedule"),
+					ScheduleDescription: aws.String("test description"),
+					ScheduleDefinitions: aws.StringSlice([]string{"rate(12 hours)"}),
+				},
+			}
+		case *redshift.DescribeTagsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID:    "123456789012",
+		Partition:    "aws",
+		Region:       "us-west-2",
+		RedshiftConn: conn,
+	}
+
+	t.Run("new resource", func(t *testing.T) {
+		describeCalls = 0
+
+		r := tfredshift.ResourceSnapshotSchedule()
+		d := r.TestResourceData()
+		d.SetId("test-schedule")
+		d.MarkNewResource()
+
+		if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if describeCalls != 3 {
+			t.Errorf("got %d DescribeSnapshotSchedules calls, expected 3", describeCalls)
+		}
+
+		if d.Id() != "test-schedule" {
+			t.Errorf("got ID %q, expected schedule to remain in state", d.Id())
+		}
+
+		if got, want := d.Get("description").(string), "test description"; got != want {
+			t.Errorf("got description %q, expected %q", got, want)
+		}
+	})
 
-func testAccCheckSnapshotScheduleDestroy(s *terraform.State) error {
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_redshift_snapshot_schedule" {
-			continue
+	t.Run("existing resource", func(t *testing.T) {
+		describeCalls = 0
+
+		r := tfredshift.ResourceSnapshotSchedule()
+		d := r.TestResourceData()
+		d.SetId("test-schedule")
+
+		if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
-		resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
-			ScheduleIdentifier: aws.String(rs.Primary.ID),
-		})
+		if describeCalls != 1 {
+			t.Errorf("got %d DescribeSnapshotSchedules calls, expected 1", describeCalls)
+		}
 
-		if err == nil {
-			if len(resp.SnapshotSchedules) != 0 {
-				for _, s := range resp.SnapshotSchedules {
-					if *s.ScheduleIdentifier == rs.Primary.ID {
-						return fmt.Errorf("Redshift Cluster Snapshot Schedule %s still exists", rs.Primary.ID)
-					}
-				}
+		if d.Id() != "" {
+			t.Errorf("got ID %q, expected schedule to be removed from state", d.Id())
+		}
+	})
+}
+
+func TestSnapshotScheduleRead_descriptionDrift(t *testing.T) {
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+				{
+					ScheduleIdentifier:  aws.String("test-schedule"),
+					ScheduleDescription: aws.String("changed outside Terraform"),
+					ScheduleDefinitions: aws.StringSlice([]string{"rate(12 hours)"}),
+				},
 			}
+		case *redshift.DescribeTagsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
 		}
+	})
 
-		return err
+	meta := &conns.AWSClient{
+		AccountID:    "123456789012",
+		Partition:    "aws",
+		Region:       "us-west-2",
+		RedshiftConn: conn,
 	}
 
-	return nil
+	r := tfredshift.ResourceSnapshotSchedule()
+	d := r.TestResourceData()
+	d.SetId("test-schedule")
+	d.Set("identifier", "test-schedule")
+	d.Set("description", "Test Schedule")
+	d.Set("definitions", []interface{}{"rate(12 hours)"})
+	d.Set("force_destroy", false)
+	d.Set("validate_only", false)
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := d.Get("description").(string), "changed outside Terraform"; got != want {
+		t.Fatalf("got description %q, expected %q", got, want)
+	}
+
+	config := map[string]interface{}{
+		"identifier":  "test-schedule",
+		"description": "Test Schedule",
+		"definitions": []interface{}{"rate(12 hours)"},
+	}
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), &conns.AWSClient{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff == nil || diff.Attributes["description"] == nil {
+		t.Fatal("expected description to change")
+	}
+
+	if got, want := diff.Attributes["description"].New, "Test Schedule"; got != want {
+		t.Errorf("got planned description %q, expected %q", got, want)
+	}
+
+	// ModifySnapshotSchedule cannot change the description, so reverting it
+	// replaces the schedule.
+	if !diff.RequiresNew() {
+		t.Error("expected description change to require replacement")
+	}
 }
 
-func testAccCheckSnapshotScheduleExists(n string, v *redshift.SnapshotSchedule) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("Not found: %s", n)
-		}
+func TestSnapshotScheduleRead_pendingAssociations(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		States   []string
+		Expected bool
+	}{
+		{
+			Name: "no associations",
+		},
+		{
+			Name:   "active",
+			States: []string{redshift.ScheduleStateActive, redshift.ScheduleStateActive},
+		},
+		{
+			Name:   "failed",
+			States: []string{redshift.ScheduleStateActive, redshift.ScheduleStateFailed},
+		},
+		{
+			Name:     "modifying",
+			States:   []string{redshift.ScheduleStateActive, redshift.ScheduleStateModifying},
+			Expected: true,
+		},
+	}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("No Redshift Cluster Snapshot Schedule ID is set")
-		}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var associatedClusters []*redshift.ClusterAssociatedToSchedule
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
-		resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
-			ScheduleIdentifier: aws.String(rs.Primary.ID),
+			for i, state := range testCase.States {
+				associatedClusters = append(associatedClusters, &redshift.ClusterAssociatedToSchedule{
+					ClusterIdentifier:        aws.String(fmt.Sprintf("cluster-%d", i)),
+					ScheduleAssociationState: aws.String(state),
+				})
+			}
+
+			conn := newMockRedshiftConn(t, func(r *request.Request) {
+				switch data := r.Data.(type) {
+				case *redshift.DescribeSnapshotSchedulesOutput:
+					data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+						{
+							AssociatedClusterCount: aws.Int64(int64(len(associatedClusters))),
+							AssociatedClusters:     associatedClusters,
+							ScheduleIdentifier:     aws.String("test-schedule"),
+							ScheduleDefinitions:    aws.StringSlice([]string{"rate(12 hours)"}),
+						},
+					}
+				case *redshift.DescribeTagsOutput:
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+
+			meta := &conns.AWSClient{
+				AccountID:    "123456789012",
+				Partition:    "aws",
+				Region:       "us-west-2",
+				RedshiftConn: conn,
+			}
+
+			r := tfredshift.ResourceSnapshotSchedule()
+			d := r.TestResourceData()
+			d.SetId("test-schedule")
+
+			if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got := d.Get("has_pending_associations").(bool); got != testCase.Expected {
+				t.Errorf("got has_pending_associations %t, expected %t", got, testCase.Expected)
+			}
+
+			if got, want := d.Get("associated_clusters.#").(int), len(testCase.States); got != want {
+				t.Fatalf("got %d associated_clusters, expected %d", got, want)
+			}
+
+			for i, state := range testCase.States {
+				if got := d.Get(fmt.Sprintf("associated_clusters.%d.schedule_association_state", i)).(string); got != state {
+					t.Errorf("got associated_clusters.%d.schedule_association_state %q, expected %q", i, got, state)
+				}
+			}
 		})
+	}
+}
 
-		if err != nil {
-			return err
-		}
+func TestSnapshotScheduleRead_orderedDefinitions(t *testing.T) {
+	// Redshift returns the definitions in a different order on every read.
+	responses := [][]string{
+		{"cron(30 12 *)", "rate(1 day)", "rate(12 hours)"},
+		{"rate(1 day)", "rate(12 hours)", "cron(30 12 *)"},
+	}
+	var describeCalls int
 
-		for _, s := range resp.SnapshotSchedules {
-			if *s.ScheduleIdentifier == rs.Primary.ID {
-				*v = *s
-				return nil
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+				{
+					ScheduleIdentifier:  aws.String("test-schedule"),
+					ScheduleDefinitions: aws.StringSlice(responses[describeCalls%len(responses)]),
+				},
 			}
+			describeCalls++
+		case *redshift.DescribeTagsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
 		}
+	})
 
-		return fmt.Errorf("Redshift Snapshot Schedule (%s) not found", rs.Primary.ID)
+	meta := &conns.AWSClient{
+		AccountID:    "123456789012",
+		Partition:    "aws",
+		Region:       "us-west-2",
+		RedshiftConn: conn,
 	}
-}
 
-func testAccCheckSnapshotScheduleCreateSnapshotScheduleAssociation(cluster *redshift.Cluster, snapshotSchedule *redshift.SnapshotSchedule) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+	r := tfredshift.ResourceSnapshotSchedule()
+	d := r.TestResourceData()
+	d.SetId("test-schedule")
+	d.Set("ordered_definitions", []interface{}{"rate(12 hours)", "rate(1 day)", "cron(30 12 *)"})
 
-		if _, err := conn.ModifyClusterSnapshotSchedule(&redshift.ModifyClusterSnapshotScheduleInput{
-			ClusterIdentifier:    cluster.ClusterIdentifier,
-			ScheduleIdentifier:   snapshotSchedule.ScheduleIdentifier,
-			DisassociateSchedule: aws.Bool(false),
-		}); err != nil {
-			return fmt.Errorf("Error associate Redshift Cluster and Snapshot Schedule: %s", err)
+	expected := []interface{}{"rate(12 hours)", "rate(1 day)", "cron(30 12 *)"}
+
+	for i := range responses {
+		if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
 		}
 
-		if err := tfredshift.WaitForSnapshotScheduleAssociationActive(conn, 75*time.Minute, aws.StringValue(cluster.ClusterIdentifier), aws.StringValue(snapshotSchedule.ScheduleIdentifier)); err != nil {
-			return err
+		if got := d.Get("ordered_definitions").([]interface{}); !reflect.DeepEqual(got, expected) {
+			t.Errorf("read %d: got ordered_definitions %v, expected %v", i+1, got, expected)
 		}
 
-		return nil
+		if got := d.Get("definitions").(*schema.Set).Len(); got != 0 {
+			t.Errorf("read %d: got %d definitions, expected none", i+1, got)
+		}
 	}
 }
 
-const testAccSnapshotScheduleWithIdentifierPrefixConfig = `
-resource "aws_redshift_snapshot_schedule" "default" {
-  identifier_prefix = "tf-acc-test"
-  definitions = [
-    "rate(12 hours)",
-  ]
-}
-`
+func TestSnapshotScheduleRenameDiff(t *testing.T) {
+	r := tfredshift.ResourceSnapshotSchedule()
+	meta := &conns.AWSClient{}
 
-func testAccSnapshotScheduleConfig(rName, definition string) string {
-	return fmt.Sprintf(`
-resource "aws_redshift_snapshot_schedule" "default" {
-  identifier = %[1]q
-  definitions = [
-    "%[2]s",
-  ]
-}
-`, rName, definition)
-}
+	d := r.TestResourceData()
+	d.SetId("old-schedule")
+	d.Set("identifier", "old-schedule")
+	d.Set("definitions", []interface{}{"rate(12 hours)"})
+	d.Set("force_destroy", false)
+	d.Set("validate_only", false)
+	state := d.State()
 
-func testAccSnapshotScheduleWithMultipleDefinitionConfig(rName, definition1, definition2 string) string {
-	return fmt.Sprintf(`
-resource "aws_redshift_snapshot_schedule" "default" {
-  identifier = %[1]q
-  definitions = [
-    "%[2]s",
-    "%[3]s",
-  ]
-}
-`, rName, definition1, definition2)
-}
+	testCases := []struct {
+		Name                string
+		Config              map[string]interface{}
+		ExpectedRequiresNew bool
+	}{
+		{
+			Name: "default",
+			Config: map[string]interface{}{
+				"identifier":  "new-schedule",
+				"definitions": []interface{}{"rate(12 hours)"},
+			},
+			ExpectedRequiresNew: true,
+		},
+		{
+			Name: "preserve associations",
+			Config: map[string]interface{}{
+				"identifier":                      "new-schedule",
+				"definitions":                     []interface{}{"rate(12 hours)"},
+				"preserve_associations_on_rename": true,
+			},
+			ExpectedRequiresNew: false,
+		},
+		{
+			Name: "preserve associations validate only",
+			Config: map[string]interface{}{
+				"identifier":                      "new-schedule",
+				"definitions":                     []interface{}{"rate(12 hours)"},
+				"preserve_associations_on_rename": true,
+				"validate_only":                   true,
+			},
+			ExpectedRequiresNew: true,
+		},
+	}
 
-func testAccSnapshotScheduleWithDescriptionConfig(rName string) string {
-	return fmt.Sprintf(`
-resource "aws_redshift_snapshot_schedule" "default" {
-  identifier  = %[1]q
-  description = "Test Schedule"
-  definitions = [
-    "rate(12 hours)",
-  ]
-}
-`, rName)
-}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(testCase.Config), meta)
 
-func testAccSnapshotScheduleWithTagsConfig(rName string) string {
-	return fmt.Sprintf(`
-resource "aws_redshift_snapshot_schedule" "default" {
-  identifier = %[1]q
-  definitions = [
-    "rate(12 hours)",
-  ]
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
 
-  tags = {
-    foo  = "bar"
-    fizz = "buzz"
-  }
-}
-`, rName)
+			if diff == nil || diff.Attributes["identifier"] == nil {
+				t.Fatal("expected identifier to change")
+			}
+
+			if got := diff.RequiresNew(); got != testCase.ExpectedRequiresNew {
+				t.Errorf("got RequiresNew %t, expected %t", got, testCase.ExpectedRequiresNew)
+			}
+		})
+	}
 }
 
-func testAccSnapshotScheduleWithTagsUpdateConfig(rName string) string {
-	return fmt.Sprintf(`
-resource "aws_redshift_snapshot_schedule" "default" {
-  identifier = %[1]q
-  definitions = [
-    "rate(12 hours)",
-  ]
+func TestSnapshotScheduleUpdate_preserveAssociationsOnRename(t *testing.T) {
+	var mu sync.Mutex
+	schedules := map[string]bool{"old-schedule": true}
+	clusterSchedules := map[string]string{"cluster-1": "old-schedule", "cluster-2": "old-schedule"}
+	var failAssociation bool
+	var creates int
 
-  tags = {
-    foo  = "bar2"
-    good = "bad"
-  }
-}
-`, rName)
-}
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		mu.Lock()
+		defer mu.Unlock()
 
-func testAccSnapshotScheduleWithForceDestroyConfig(rName string) string {
-	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
-resource "aws_redshift_snapshot_schedule" "default" {
-  identifier  = %[1]q
-  description = "Test Schedule"
-  definitions = [
-    "rate(12 hours)",
-  ]
-  force_destroy = true
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			input := r.Params.(*redshift.DescribeSnapshotSchedulesInput)
+			scheduleIdentifier := aws.StringValue(input.ScheduleIdentifier)
+
+			if !schedules[scheduleIdentifier] {
+				return
+			}
+
+			snapshotSchedule := &redshift.SnapshotSchedule{
+				ScheduleIdentifier:  aws.String(scheduleIdentifier),
+				ScheduleDefinitions: aws.StringSlice([]string{"rate(12 hours)"}),
+			}
+
+			for _, clusterIdentifier := range []string{"cluster-1", "cluster-2"} {
+				if clusterSchedules[clusterIdentifier] != scheduleIdentifier {
+					continue
+				}
+
+				if v := aws.StringValue(input.ClusterIdentifier); v != "" && v != clusterIdentifier {
+					continue
+				}
+
+				snapshotSchedule.AssociatedClusters = append(snapshotSchedule.AssociatedClusters, &redshift.ClusterAssociatedToSchedule{
+					ClusterIdentifier:        aws.String(clusterIdentifier),
+					ScheduleAssociationState: aws.String(redshift.ScheduleStateActive),
+				})
+			}
+
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{snapshotSchedule}
+		case *redshift.CreateSnapshotScheduleOutput:
+			scheduleIdentifier := aws.StringValue(r.Params.(*redshift.CreateSnapshotScheduleInput).ScheduleIdentifier)
+
+			if schedules[scheduleIdentifier] {
+				r.Error = awserr.New(redshift.ErrCodeSnapshotScheduleAlreadyExistsFault, "already exists", nil)
+				return
+			}
+
+			creates++
+			schedules[scheduleIdentifier] = true
+			data.ScheduleIdentifier = aws.String(scheduleIdentifier)
+		case *redshift.ModifyClusterSnapshotScheduleOutput:
+			input := r.Params.(*redshift.ModifyClusterSnapshotScheduleInput)
+			clusterIdentifier := aws.StringValue(input.ClusterIdentifier)
+
+			if clusterIdentifier == "cluster-2" && failAssociation {
+				r.Error = awserr.New(redshift.ErrCodeInvalidClusterSnapshotScheduleStateFault, "cluster is busy", nil)
+				return
+			}
+
+			clusterSchedules[clusterIdentifier] = aws.StringValue(input.ScheduleIdentifier)
+		case *redshift.DeleteSnapshotScheduleOutput:
+			scheduleIdentifier := aws.StringValue(r.Params.(*redshift.DeleteSnapshotScheduleInput).ScheduleIdentifier)
+
+			for clusterIdentifier, v := range clusterSchedules {
+				if v == scheduleIdentifier {
+					t.Errorf("Redshift Snapshot Schedule (%s) deleted while associated with Redshift Cluster (%s)", scheduleIdentifier, clusterIdentifier)
+				}
+			}
+
+			delete(schedules, scheduleIdentifier)
+		case *redshift.DescribeTagsOutput:
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID:    "123456789012",
+		Partition:    "aws",
+		Region:       "us-west-2",
+		RedshiftConn: conn,
+	}
+
+	r := tfredshift.ResourceSnapshotSchedule()
+
+	d := r.TestResourceData()
+	d.SetId("old-schedule")
+	d.Set("identifier", "old-schedule")
+	d.Set("definitions", []interface{}{"rate(12 hours)"})
+	d.Set("force_destroy", false)
+	d.Set("preserve_associations_on_rename", true)
+	d.Set("validate_only", false)
+	state := d.State()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"identifier":                      "new-schedule",
+		"definitions":                     []interface{}{"rate(12 hours)"},
+		"preserve_associations_on_rename": true,
+	})
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The first attempt fails part way through, after the new schedule is created.
+	failAssociation = true
+
+	newState, diags := r.Apply(context.Background(), state, diff, meta)
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	if newState == nil || newState.ID != "old-schedule" || newState.Attributes["identifier"] != "old-schedule" {
+		t.Fatalf("expected the old identifier to remain in state, got: %#v", newState)
+	}
+
+	if !schedules["old-schedule"] {
+		t.Fatal("expected the old schedule not to be deleted while clusters are still associated with it")
+	}
+
+	// Retrying reuses the schedule created by the failed attempt.
+	failAssociation = false
+
+	newState, diags = r.Apply(context.Background(), newState, diff, meta)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, expected := newState.ID, "new-schedule"; got != expected {
+		t.Errorf("got ID %s, expected %s", got, expected)
+	}
+
+	if got, expected := newState.Attributes["identifier"], "new-schedule"; got != expected {
+		t.Errorf("got identifier %s, expected %s", got, expected)
+	}
+
+	if creates != 1 {
+		t.Errorf("expected the new schedule to be created once, got %d", creates)
+	}
+
+	if schedules["old-schedule"] {
+		t.Error("expected the old schedule to be deleted")
+	}
+
+	for _, clusterIdentifier := range []string{"cluster-1", "cluster-2"} {
+		if got, expected := clusterSchedules[clusterIdentifier], "new-schedule"; got != expected {
+			t.Errorf("got Redshift Cluster (%s) associated with %s, expected %s", clusterIdentifier, got, expected)
+		}
+	}
 }
-`, rName))
+
+// newMockRedshiftConn returns a Redshift client that hands every request to send
+// instead of calling AWS. send fills in the request's output or sets its error.
+func newMockRedshiftConn(t *testing.T, send func(r *request.Request)) *redshift.Redshift {
+	t.Helper()
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := redshift.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(send)
+
+	return conn
 }
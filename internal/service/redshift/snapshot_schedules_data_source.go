@@ -0,0 +1,76 @@
+package redshift
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceSnapshotSchedules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSnapshotSchedulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceSnapshotSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient)
+	conn := client.RedshiftConn
+
+	tags := tftags.New(d.Get("tags").(map[string]interface{}))
+	input := &redshift.DescribeSnapshotSchedulesInput{}
+
+	// Schedules with any of the tag keys are returned, those without all of the tags are dropped below.
+	if len(tags) > 0 {
+		input.TagKeys = aws.StringSlice(tags.Keys())
+	}
+
+	snapshotSchedules, err := FindSnapshotSchedules(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("error reading Redshift Snapshot Schedules: %s", err)
+	}
+
+	var arns, identifiers []string
+
+	for _, snapshotSchedule := range snapshotSchedules {
+		if !KeyValueTags(snapshotSchedule.Tags).ContainsAll(tags) {
+			continue
+		}
+
+		identifier := aws.StringValue(snapshotSchedule.ScheduleIdentifier)
+		arn, err := client.RegionalARN(redshift.ServiceName, fmt.Sprintf("snapshotschedule:%s", identifier))
+
+		if err != nil {
+			return diag.Errorf("error reading Redshift Snapshot Schedule (%s): %s", identifier, err)
+		}
+
+		arns = append(arns, arn)
+		identifiers = append(identifiers, identifier)
+	}
+
+	d.SetId(client.Region)
+	d.Set("arns", arns)
+	d.Set("identifiers", identifiers)
+
+	return nil
+}
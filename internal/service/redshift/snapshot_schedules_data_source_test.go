@@ -0,0 +1,190 @@
+package redshift_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+)
+
+func TestSnapshotSchedulesDataSourceRead(t *testing.T) {
+	var tagKeys []string
+	conn := newMockRedshiftConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *redshift.DescribeSnapshotSchedulesOutput:
+			input := r.Params.(*redshift.DescribeSnapshotSchedulesInput)
+			tagKeys = aws.StringValueSlice(input.TagKeys)
+
+			if aws.StringValue(input.Marker) == "" {
+				data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+					{
+						ScheduleIdentifier: aws.String("schedule-1"),
+						Tags: []*redshift.Tag{
+							{Key: aws.String("Environment"), Value: aws.String("production")},
+							{Key: aws.String("Team"), Value: aws.String("data")},
+						},
+					},
+					{
+						ScheduleIdentifier: aws.String("schedule-2"),
+						Tags: []*redshift.Tag{
+							{Key: aws.String("Environment"), Value: aws.String("staging")},
+							{Key: aws.String("Team"), Value: aws.String("data")},
+						},
+					},
+				}
+				data.Marker = aws.String("page2")
+				return
+			}
+
+			data.SnapshotSchedules = []*redshift.SnapshotSchedule{
+				{
+					ScheduleIdentifier: aws.String("schedule-3"),
+					Tags: []*redshift.Tag{
+						{Key: aws.String("Environment"), Value: aws.String("production")},
+					},
+				},
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID:    "123456789012",
+		Partition:    "aws",
+		Region:       "us-west-2",
+		RedshiftConn: conn,
+	}
+
+	testCases := []struct {
+		Name                string
+		Tags                map[string]interface{}
+		ExpectedIdentifiers []interface{}
+		ExpectedTagKeys     []string
+	}{
+		{
+			Name:                "all",
+			ExpectedIdentifiers: []interface{}{"schedule-1", "schedule-2", "schedule-3"},
+		},
+		{
+			Name:                "single tag",
+			Tags:                map[string]interface{}{"Environment": "production"},
+			ExpectedIdentifiers: []interface{}{"schedule-1", "schedule-3"},
+			ExpectedTagKeys:     []string{"Environment"},
+		},
+		{
+			Name:                "all tags must match",
+			Tags:                map[string]interface{}{"Environment": "production", "Team": "data"},
+			ExpectedIdentifiers: []interface{}{"schedule-1"},
+			ExpectedTagKeys:     []string{"Environment", "Team"},
+		},
+		{
+			Name:            "no match",
+			Tags:            map[string]interface{}{"Environment": "development"},
+			ExpectedTagKeys: []string{"Environment"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := tfredshift.DataSourceSnapshotSchedules()
+			d := r.TestResourceData()
+			d.Set("tags", testCase.Tags)
+
+			if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			sort.Strings(tagKeys)
+
+			if (len(tagKeys) > 0 || len(testCase.ExpectedTagKeys) > 0) && !reflect.DeepEqual(tagKeys, testCase.ExpectedTagKeys) {
+				t.Errorf("got TagKeys %v, expected %v", tagKeys, testCase.ExpectedTagKeys)
+			}
+
+			if got := d.Get("identifiers").([]interface{}); (len(got) > 0 || len(testCase.ExpectedIdentifiers) > 0) && !reflect.DeepEqual(got, testCase.ExpectedIdentifiers) {
+				t.Errorf("got identifiers %v, expected %v", got, testCase.ExpectedIdentifiers)
+			}
+
+			arns := d.Get("arns").([]interface{})
+
+			if len(arns) != len(testCase.ExpectedIdentifiers) {
+				t.Fatalf("got %d arns, expected %d", len(arns), len(testCase.ExpectedIdentifiers))
+			}
+
+			for i, identifier := range testCase.ExpectedIdentifiers {
+				if got, want := arns[i], fmt.Sprintf("arn:aws:redshift:us-west-2:123456789012:snapshotschedule:%s", identifier); got != want { //lintignore:AWSAT003,AWSAT005
+					t.Errorf("got arn %s, expected %s", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAccRedshiftSnapshotSchedulesDataSource_tags(t *testing.T) {
+	dataSourceName := "data.aws_redshift_snapshot_schedules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotSchedulesDataSourceTagsConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "identifiers.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "identifiers.*", "aws_redshift_snapshot_schedule.test.0", "identifier"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "identifiers.*", "aws_redshift_snapshot_schedule.test.1", "identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_redshift_snapshot_schedule.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_redshift_snapshot_schedule.test.1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSnapshotSchedulesDataSourceTagsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "test" {
+  count = 2
+
+  identifier  = "%[1]s-${count.index}"
+  definitions = ["rate(12 hours)"]
+
+  tags = {
+    Name  = %[1]q
+    Group = "selected"
+  }
+}
+
+resource "aws_redshift_snapshot_schedule" "other" {
+  identifier  = "%[1]s-other"
+  definitions = ["rate(12 hours)"]
+
+  tags = {
+    Name  = %[1]q
+    Group = "other"
+  }
+}
+
+data "aws_redshift_snapshot_schedules" "test" {
+  tags = {
+    Name  = %[1]q
+    Group = "selected"
+  }
+
+  depends_on = [aws_redshift_snapshot_schedule.test, aws_redshift_snapshot_schedule.other]
+}
+`, rName)
+}
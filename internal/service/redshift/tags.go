@@ -0,0 +1,44 @@
+package redshift
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ListTags lists redshift service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+// DescribeTags returns one tagged resource per tag, so the tags are collected
+// from every page rather than from a single output element.
+func ListTags(ctx context.Context, conn *redshift.Redshift, identifier string) (tftags.KeyValueTags, error) {
+	input := &redshift.DescribeTagsInput{
+		ResourceName: aws.String(identifier),
+	}
+
+	var tags []*redshift.Tag
+
+	err := conn.DescribeTagsPagesWithContext(ctx, input, func(page *redshift.DescribeTagsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, taggedResource := range page.TaggedResources {
+			if taggedResource == nil || taggedResource.Tag == nil {
+				continue
+			}
+
+			tags = append(tags, taggedResource.Tag)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(tags), nil
+}
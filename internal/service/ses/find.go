@@ -0,0 +1,59 @@
+package ses
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// FindReceiptFilters returns all receipt filters in the order SES evaluates them.
+// SES has no API to get a single receipt filter, so the result of ListReceiptFilters
+// is shared between reads through the client's SESReceiptFilters cache, which is
+// invalidated whenever a receipt filter is created or deleted through the client.
+func FindReceiptFilters(ctx context.Context, client *conns.AWSClient) ([]*ses.ReceiptFilter, error) {
+	outputRaw, err := client.SESReceiptFilters.Get(func() (interface{}, error) {
+		output, err := client.SESConn.ListReceiptFiltersWithContext(ctx, &ses.ListReceiptFiltersInput{})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return []*ses.ReceiptFilter(nil), nil
+		}
+
+		return output.Filters, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The cached slice is shared with concurrent reads.
+	filters := outputRaw.([]*ses.ReceiptFilter)
+
+	return append([]*ses.ReceiptFilter(nil), filters...), nil
+}
+
+func FindReceiptFilterByName(ctx context.Context, client *conns.AWSClient, name string) (*ses.ReceiptFilter, error) {
+	filters, err := FindReceiptFilters(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, filter := range filters {
+		if aws.StringValue(filter.Name) == name {
+			return filter, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: name,
+		Message:     fmt.Sprintf("SES Receipt Filter (%s) not found", name),
+	}
+}
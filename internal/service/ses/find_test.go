@@ -0,0 +1,148 @@
+package ses
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestFindReceiptFilterByName(t *testing.T) {
+	var listCalls int
+	conn := newMockSESConn(t, func(r *request.Request) {
+		listCalls++
+		data := r.Data.(*ses.ListReceiptFiltersOutput)
+		data.Filters = []*ses.ReceiptFilter{
+			{Name: aws.String("filter1")},
+			{Name: aws.String("filter2")},
+			{Name: aws.String("filter3")},
+		}
+	})
+
+	client := &conns.AWSClient{SESConn: conn}
+
+	for _, name := range []string{"filter1", "filter2", "filter3"} {
+		filter, err := FindReceiptFilterByName(context.Background(), client, name)
+
+		if err != nil {
+			t.Fatalf("unexpected error finding %s: %s", name, err)
+		}
+
+		if got := aws.StringValue(filter.Name); got != name {
+			t.Errorf("got %s, expected %s", got, name)
+		}
+	}
+
+	if _, err := FindReceiptFilterByName(context.Background(), client, "filter4"); !tfresource.NotFound(err) {
+		t.Errorf("expected not found error, got: %v", err)
+	} else if !strings.Contains(err.Error(), "filter4") {
+		t.Errorf("expected not found error %q to name the filter", err)
+	}
+
+	if listCalls != 1 {
+		t.Errorf("got %d ListReceiptFilters calls, expected 1", listCalls)
+	}
+
+	client.SESReceiptFilters.Invalidate()
+
+	if _, err := FindReceiptFilterByName(context.Background(), client, "filter1"); err != nil {
+		t.Fatalf("unexpected error finding filter1: %s", err)
+	}
+
+	if listCalls != 2 {
+		t.Errorf("got %d ListReceiptFilters calls after invalidation, expected 2", listCalls)
+	}
+
+	// Another provider configuration does not share the cached filters.
+	if _, err := FindReceiptFilterByName(context.Background(), &conns.AWSClient{SESConn: conn}, "filter1"); err != nil {
+		t.Fatalf("unexpected error finding filter1: %s", err)
+	}
+
+	if listCalls != 3 {
+		t.Errorf("got %d ListReceiptFilters calls through another client, expected 3", listCalls)
+	}
+}
+
+func TestFindReceiptFilters_sharedAcrossReads(t *testing.T) {
+	var listCalls int
+	conn := newMockSESConn(t, func(r *request.Request) {
+		listCalls++
+		data := r.Data.(*ses.ListReceiptFiltersOutput)
+		data.Filters = []*ses.ReceiptFilter{
+			{
+				Name:     aws.String("filter1"),
+				IpFilter: &ses.ReceiptIpFilter{Cidr: aws.String("10.0.0.0/24"), Policy: aws.String(ses.ReceiptFilterPolicyBlock)},
+			},
+			{
+				Name:     aws.String("filter2"),
+				IpFilter: &ses.ReceiptIpFilter{Cidr: aws.String("10.0.0.0/16"), Policy: aws.String("allow")},
+			},
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    "us-west-2",
+		SESConn:   conn,
+	}
+
+	r := ResourceReceiptFilter()
+	d := r.TestResourceData()
+	d.SetId("filter1")
+
+	if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error reading resource: %v", diags)
+	}
+
+	ds := DataSourceReceiptFilter()
+	dd := ds.TestResourceData()
+	dd.Set("name", "filter2")
+
+	if diags := ds.ReadContext(context.Background(), dd, meta); diags.HasError() {
+		t.Fatalf("unexpected error reading filter data source: %v", diags)
+	}
+
+	if got, want := dd.Get("policy").(string), ses.ReceiptFilterPolicyAllow; got != want {
+		t.Errorf("got policy %s, expected %s", got, want)
+	}
+
+	for name, ds := range map[string]*schema.Resource{
+		"filters":   DataSourceReceiptFilters(),
+		"conflicts": DataSourceReceiptFilterConflicts(),
+	} {
+		d := ds.TestResourceData()
+
+		if diags := ds.ReadContext(context.Background(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error reading %s data source: %v", name, diags)
+		}
+	}
+
+	if listCalls != 1 {
+		t.Errorf("got %d ListReceiptFilters calls, expected 1", listCalls)
+	}
+}
+
+// newMockSESConn returns an SES client that hands every request to send instead
+// of calling AWS. send fills in the request's output or sets its error.
+func newMockSESConn(t *testing.T, send func(r *request.Request)) *ses.SES {
+	t.Helper()
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := ses.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(send)
+
+	return conn
+}
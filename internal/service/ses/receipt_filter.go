@@ -1,25 +1,29 @@
 package ses
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceReceiptFilter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceReceiptFilterCreate,
-		Read:   resourceReceiptFilterRead,
-		Delete: resourceReceiptFilterDelete,
+		CreateContext: resourceReceiptFilterCreate,
+		ReadContext:   resourceReceiptFilterRead,
+		DeleteContext: resourceReceiptFilterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -40,13 +44,10 @@ func ResourceReceiptFilter() *schema.Resource {
 			},
 
 			"cidr": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.Any(
-					validation.IsCIDR,
-					validation.IsIPv4Address,
-				),
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validReceiptFilterCIDR,
 			},
 
 			"policy": {
@@ -62,7 +63,7 @@ func ResourceReceiptFilter() *schema.Resource {
 	}
 }
 
-func resourceReceiptFilterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceReceiptFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).SESConn
 
 	name := d.Get("name").(string)
@@ -77,67 +78,99 @@ func resourceReceiptFilterCreate(d *schema.ResourceData, meta interface{}) error
 		},
 	}
 
-	_, err := conn.CreateReceiptFilter(createOpts)
+	_, err := conn.CreateReceiptFilterWithContext(ctx, createOpts)
+	meta.(*conns.AWSClient).SESReceiptFilters.Invalidate()
 	if err != nil {
-		return fmt.Errorf("Error creating SES receipt filter: %s", err)
+		return diag.Errorf("Error creating SES receipt filter: %s", err)
 	}
 
 	d.SetId(name)
 
-	return resourceReceiptFilterRead(d, meta)
+	return resourceReceiptFilterRead(ctx, d, meta)
 }
 
-func resourceReceiptFilterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+// receiptFilterCIDRsOverlap reports whether two receipt filter CIDRs share any
+// address. Single IP addresses are treated as host-length prefixes.
+func receiptFilterCIDRsOverlap(a, b string) bool {
+	netA, netB := parseReceiptFilterCIDR(a), parseReceiptFilterCIDR(b)
 
-	listOpts := &ses.ListReceiptFiltersInput{}
+	if netA == nil || netB == nil {
+		return false
+	}
 
-	response, err := conn.ListReceiptFilters(listOpts)
-	if err != nil {
-		return err
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP)
+}
+
+func parseReceiptFilterCIDR(v string) *net.IPNet {
+	if _, ipNet, err := net.ParseCIDR(v); err == nil {
+		return ipNet
 	}
 
-	var filter *ses.ReceiptFilter
+	ip := net.ParseIP(v)
 
-	for _, responseFilter := range response.Filters {
-		if aws.StringValue(responseFilter.Name) == d.Id() {
-			filter = responseFilter
-			break
-		}
+	if ip == nil {
+		return nil
 	}
 
-	if filter == nil {
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+func resourceReceiptFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filter, err := FindReceiptFilterByName(ctx, meta.(*conns.AWSClient), d.Id())
+
+	if tfresource.NotFound(err) {
 		log.Printf("[WARN] SES Receipt Filter (%s) not found", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return diag.Errorf("error reading SES Receipt Filter (%s): %s", d.Id(), err)
+	}
+
 	d.Set("cidr", filter.IpFilter.Cidr)
-	d.Set("policy", filter.IpFilter.Policy)
+	d.Set("policy", normalizeReceiptFilterPolicy(aws.StringValue(filter.IpFilter.Policy)))
 	d.Set("name", filter.Name)
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("receipt-filter/%s", d.Id()),
-	}.String()
+	arn, err := meta.(*conns.AWSClient).RegionalARN("ses", fmt.Sprintf("receipt-filter/%s", d.Id()))
+
+	if err != nil {
+		return diag.Errorf("error reading SES Receipt Filter (%s): %s", d.Id(), err)
+	}
+
 	d.Set("arn", arn)
 
 	return nil
 }
 
-func resourceReceiptFilterDelete(d *schema.ResourceData, meta interface{}) error {
+// normalizeReceiptFilterPolicy returns the canonical casing of a receipt
+// filter policy so that imported filters match the configured value.
+// Unrecognized policies are returned unchanged.
+func normalizeReceiptFilterPolicy(policy string) string {
+	for _, v := range ses.ReceiptFilterPolicy_Values() {
+		if strings.EqualFold(policy, v) {
+			return v
+		}
+	}
+
+	return policy
+}
+
+func resourceReceiptFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).SESConn
 
 	deleteOpts := &ses.DeleteReceiptFilterInput{
 		FilterName: aws.String(d.Id()),
 	}
 
-	_, err := conn.DeleteReceiptFilter(deleteOpts)
+	_, err := conn.DeleteReceiptFilterWithContext(ctx, deleteOpts)
+	meta.(*conns.AWSClient).SESReceiptFilters.Invalidate()
 	if err != nil {
-		return fmt.Errorf("Error deleting SES receipt filter: %s", err)
+		return diag.Errorf("Error deleting SES receipt filter: %s", err)
 	}
 
 	return nil
@@ -0,0 +1,68 @@
+package ses
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+)
+
+func TestFlattenReceiptFilterConflicts(t *testing.T) {
+	filter := func(name, cidr, policy string) *ses.ReceiptFilter {
+		return &ses.ReceiptFilter{
+			Name: aws.String(name),
+			IpFilter: &ses.ReceiptIpFilter{
+				Cidr:   aws.String(cidr),
+				Policy: aws.String(policy),
+			},
+		}
+	}
+
+	filters := []*ses.ReceiptFilter{
+		filter("allow-office", "10.10.0.0/16", ses.ReceiptFilterPolicyAllow),
+		filter("block-host", "10.10.10.10", ses.ReceiptFilterPolicyBlock),
+		filter("allow-subnet", "10.10.10.0/24", ses.ReceiptFilterPolicyAllow),
+		filter("block-other", "192.168.0.0/16", ses.ReceiptFilterPolicyBlock),
+		filter("block-v6", "2001:db8::/32", ses.ReceiptFilterPolicyBlock),
+		filter("allow-v6-host", "2001:db8::1", ses.ReceiptFilterPolicyAllow),
+		nil,
+		{Name: aws.String("no-ip-filter")},
+	}
+
+	got := flattenReceiptFilterConflicts(filters)
+	expected := []interface{}{
+		map[string]interface{}{"name_a": "allow-office", "name_b": "block-host", "cidr_a": "10.10.0.0/16", "cidr_b": "10.10.10.10"},
+		map[string]interface{}{"name_a": "block-host", "name_b": "allow-subnet", "cidr_a": "10.10.10.10", "cidr_b": "10.10.10.0/24"},
+		map[string]interface{}{"name_a": "block-v6", "name_b": "allow-v6-host", "cidr_a": "2001:db8::/32", "cidr_b": "2001:db8::1"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	if got := flattenReceiptFilterConflicts(filters[3:4]); len(got) != 0 {
+		t.Errorf("got %v, expected no conflicts for a single filter", got)
+	}
+}
+
+func TestNormalizeReceiptFilterPolicy(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "Allow", expected: ses.ReceiptFilterPolicyAllow},
+		{input: "ALLOW", expected: ses.ReceiptFilterPolicyAllow},
+		{input: "allow", expected: ses.ReceiptFilterPolicyAllow},
+		{input: "Block", expected: ses.ReceiptFilterPolicyBlock},
+		{input: "BLOCK", expected: ses.ReceiptFilterPolicyBlock},
+		{input: "", expected: ""},
+		{input: "Quarantine", expected: "Quarantine"},
+	}
+
+	for _, testCase := range testCases {
+		if got := normalizeReceiptFilterPolicy(testCase.input); got != testCase.expected {
+			t.Errorf("normalizeReceiptFilterPolicy(%q) = %q, expected %q", testCase.input, got, testCase.expected)
+		}
+	}
+}
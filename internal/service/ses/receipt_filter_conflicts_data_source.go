@@ -0,0 +1,94 @@
+package ses
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceReceiptFilterConflicts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceReceiptFilterConflictsRead,
+
+		Schema: map[string]*schema.Schema{
+			"conflicts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_a": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_b": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name_a": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name_b": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReceiptFilterConflictsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filters, err := FindReceiptFilters(ctx, meta.(*conns.AWSClient))
+
+	if err != nil {
+		return diag.Errorf("error listing SES Receipt Filters: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("conflicts", flattenReceiptFilterConflicts(filters)); err != nil {
+		return diag.Errorf("error setting conflicts: %s", err)
+	}
+
+	return nil
+}
+
+// flattenReceiptFilterConflicts returns each pair of receipt filters whose CIDR
+// ranges overlap but whose policies differ. Filter A precedes filter B in the
+// order returned by the API.
+func flattenReceiptFilterConflicts(apiObjects []*ses.ReceiptFilter) []interface{} {
+	var tfList []interface{}
+
+	for i, a := range apiObjects {
+		if a == nil || a.IpFilter == nil {
+			continue
+		}
+
+		for _, b := range apiObjects[i+1:] {
+			if b == nil || b.IpFilter == nil {
+				continue
+			}
+
+			cidrA, cidrB := aws.StringValue(a.IpFilter.Cidr), aws.StringValue(b.IpFilter.Cidr)
+
+			if aws.StringValue(a.IpFilter.Policy) == aws.StringValue(b.IpFilter.Policy) || !receiptFilterCIDRsOverlap(cidrA, cidrB) {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"cidr_a": cidrA,
+				"cidr_b": cidrB,
+				"name_a": aws.StringValue(a.Name),
+				"name_b": aws.StringValue(b.Name),
+			})
+		}
+	}
+
+	return tfList
+}
@@ -0,0 +1,83 @@
+package ses_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ses"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSESReceiptFilterConflictsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ses_receipt_filter_conflicts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckSESReceiptRule(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ses.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSESReceiptFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceiptFilterConflictsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptFilterConflictsDataSourceConflict(dataSourceName, rName+"-allow", rName+"-block"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckReceiptFilterConflictsDataSourceConflict checks that the two filters
+// are reported as a conflicting pair, in either order.
+func testAccCheckReceiptFilterConflictsDataSourceConflict(n, name1, name2 string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["conflicts.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			nameA := rs.Primary.Attributes[fmt.Sprintf("conflicts.%d.name_a", i)]
+			nameB := rs.Primary.Attributes[fmt.Sprintf("conflicts.%d.name_b", i)]
+
+			if (nameA == name1 && nameB == name2) || (nameA == name2 && nameB == name1) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("SES receipt filters %s and %s not reported as conflicting", name1, name2)
+	}
+}
+
+func testAccReceiptFilterConflictsDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_filter" "allow" {
+  cidr   = "10.20.30.0/24"
+  name   = "%[1]s-allow"
+  policy = "Allow"
+}
+
+resource "aws_ses_receipt_filter" "block" {
+  cidr   = "10.20.30.40"
+  name   = "%[1]s-block"
+  policy = "Block"
+}
+
+data "aws_ses_receipt_filter_conflicts" "test" {
+  depends_on = [
+    aws_ses_receipt_filter.allow,
+    aws_ses_receipt_filter.block,
+  ]
+}
+`, rName)
+}
@@ -0,0 +1,67 @@
+package ses
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceReceiptFilter() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceReceiptFilterRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceReceiptFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	filter, err := FindReceiptFilterByName(ctx, meta.(*conns.AWSClient), name)
+
+	if tfresource.NotFound(err) {
+		return diag.Errorf("no SES Receipt Filter found with name %q", name)
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SES Receipt Filter (%s): %s", name, err)
+	}
+
+	arn, err := meta.(*conns.AWSClient).RegionalARN("ses", fmt.Sprintf("receipt-filter/%s", aws.StringValue(filter.Name)))
+
+	if err != nil {
+		return diag.Errorf("error reading SES Receipt Filter (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(filter.Name))
+	d.Set("arn", arn)
+
+	if v := filter.IpFilter; v != nil {
+		d.Set("cidr", v.Cidr)
+		d.Set("policy", normalizeReceiptFilterPolicy(aws.StringValue(v.Policy)))
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package ses_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ses"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSESReceiptFilterDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ses_receipt_filter.test"
+	resourceName := "aws_ses_receipt_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckSESReceiptRule(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ses.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSESReceiptFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReceiptFilterDataSourceNonExistentConfig(rName),
+				ExpectError: regexp.MustCompile(`no SES Receipt Filter found with name`),
+			},
+			{
+				Config: testAccReceiptFilterDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cidr", resourceName, "cidr"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy", resourceName, "policy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReceiptFilterDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_filter" "test" {
+  cidr   = "10.10.10.10"
+  name   = %[1]q
+  policy = "Block"
+}
+
+data "aws_ses_receipt_filter" "test" {
+  name = aws_ses_receipt_filter.test.name
+}
+`, rName)
+}
+
+func testAccReceiptFilterDataSourceNonExistentConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ses_receipt_filter" "test" {
+  name = %[1]q
+}
+`, rName)
+}
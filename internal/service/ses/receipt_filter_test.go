@@ -43,6 +43,34 @@ func TestAccSESReceiptFilter_basic(t *testing.T) {
 	})
 }
 
+func TestAccSESReceiptFilter_allowPolicy(t *testing.T) {
+	resourceName := "aws_ses_receipt_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckSESReceiptRule(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ses.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSESReceiptFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceiptFilterPolicyConfig(rName, ses.ReceiptFilterPolicyAllow),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReceiptFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr", "10.10.10.10"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy", "Allow"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSESReceiptFilter_disappears(t *testing.T) {
 	resourceName := "aws_ses_receipt_filter.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -118,11 +146,15 @@ func testAccCheckReceiptFilterExists(n string) resource.TestCheckFunc {
 }
 
 func testAccReceiptFilterConfig(rName string) string {
+	return testAccReceiptFilterPolicyConfig(rName, ses.ReceiptFilterPolicyBlock)
+}
+
+func testAccReceiptFilterPolicyConfig(rName, policy string) string {
 	return fmt.Sprintf(`
 resource "aws_ses_receipt_filter" "test" {
   cidr   = "10.10.10.10"
-  name   = %q
-  policy = "Block"
+  name   = %[1]q
+  policy = %[2]q
 }
-`, rName)
+`, rName, policy)
 }
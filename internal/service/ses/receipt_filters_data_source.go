@@ -0,0 +1,84 @@
+package ses
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceReceiptFilters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceReceiptFiltersRead,
+
+		Schema: map[string]*schema.Schema{
+			// Receipt filters are evaluated in order, so a list is used to preserve the ordering returned by the API.
+			"filters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReceiptFiltersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filters, err := FindReceiptFilters(ctx, meta.(*conns.AWSClient))
+
+	if err != nil {
+		return diag.Errorf("error listing SES Receipt Filters: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("filters", flattenReceiptFilters(filters)); err != nil {
+		return diag.Errorf("error setting filters: %s", err)
+	}
+
+	return nil
+}
+
+func flattenReceiptFilters(apiObjects []*ses.ReceiptFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.IpFilter; v != nil {
+			tfMap["cidr"] = aws.StringValue(v.Cidr)
+			tfMap["policy"] = normalizeReceiptFilterPolicy(aws.StringValue(v.Policy))
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
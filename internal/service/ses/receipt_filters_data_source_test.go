@@ -0,0 +1,98 @@
+package ses_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccSESReceiptFiltersDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ses_receipt_filters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckSESReceiptRule(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ses.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSESReceiptFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReceiptFiltersDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "filters.*", map[string]string{
+						"cidr":   "10.10.10.0/24",
+						"name":   rName + "-allow",
+						"policy": "Allow",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "filters.*", map[string]string{
+						"cidr":   "10.10.10.10",
+						"name":   rName + "-block",
+						"policy": "Block",
+					}),
+					testAccCheckReceiptFiltersDataSourceOrder(dataSourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckReceiptFiltersDataSourceOrder verifies that the data source lists
+// receipt filters in the same order as returned by the API.
+func testAccCheckReceiptFiltersDataSourceOrder(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn
+
+		response, err := conn.ListReceiptFilters(&ses.ListReceiptFiltersInput{})
+		if err != nil {
+			return err
+		}
+
+		if got, want := rs.Primary.Attributes["filters.#"], strconv.Itoa(len(response.Filters)); got != want {
+			return fmt.Errorf("SES receipt filters count: got %s, expected %s", got, want)
+		}
+
+		for i, filter := range response.Filters {
+			if got, want := rs.Primary.Attributes[fmt.Sprintf("filters.%d.name", i)], aws.StringValue(filter.Name); got != want {
+				return fmt.Errorf("SES receipt filter at index %d: got %s, expected %s", i, got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccReceiptFiltersDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_filter" "allow" {
+  cidr   = "10.10.10.0/24"
+  name   = "%[1]s-allow"
+  policy = "Allow"
+}
+
+resource "aws_ses_receipt_filter" "block" {
+  cidr   = "10.10.10.10"
+  name   = "%[1]s-block"
+  policy = "Block"
+}
+
+data "aws_ses_receipt_filters" "test" {
+  depends_on = [
+    aws_ses_receipt_filter.allow,
+    aws_ses_receipt_filter.block,
+  ]
+}
+`, rName)
+}
@@ -0,0 +1,39 @@
+package ses
+
+import (
+	"fmt"
+	"net"
+)
+
+// receiptFilterIPv6Enabled controls whether IPv6 addresses and ranges are accepted
+// by receipt filters. SES currently only supports IPv4 receipt filters.
+var receiptFilterIPv6Enabled = false
+
+// validReceiptFilterCIDR validates that the value is an IP address or CIDR block
+// of an address family supported by SES receipt filters.
+func validReceiptFilterCIDR(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	ip := net.ParseIP(value)
+
+	if ip == nil {
+		var err error
+
+		ip, _, err = net.ParseCIDR(value)
+
+		if err != nil {
+			errors = append(errors, fmt.Errorf("expected %s to be a valid IP address or CIDR block, got: %s", k, value))
+			return
+		}
+	}
+
+	if ip.To4() == nil && !receiptFilterIPv6Enabled {
+		errors = append(errors, fmt.Errorf("%s (%s) is an IPv6 address or CIDR block, SES receipt filters only support IPv4", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,55 @@
+package ses
+
+import (
+	"testing"
+)
+
+func TestValidReceiptFilterCIDR(t *testing.T) {
+	validIPv4 := []string{
+		"10.10.10.10",
+		"10.10.10.0/24",
+		"0.0.0.0/0",
+	}
+	validIPv6 := []string{
+		"2001:db8::1",
+		"2001:db8::/32",
+	}
+	invalid := []string{
+		"",
+		"not-an-ip",
+		"10.10.10.256",
+		"10.10.10.0/33",
+		"2001:db8::/129",
+	}
+
+	for _, v := range validIPv4 {
+		_, errors := validReceiptFilterCIDR(v, "cidr")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SES receipt filter CIDR: %q", v, errors)
+		}
+	}
+
+	for _, v := range append(validIPv6, invalid...) {
+		_, errors := validReceiptFilterCIDR(v, "cidr")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SES receipt filter CIDR", v)
+		}
+	}
+
+	receiptFilterIPv6Enabled = true
+	defer func() { receiptFilterIPv6Enabled = false }()
+
+	for _, v := range append(validIPv4, validIPv6...) {
+		_, errors := validReceiptFilterCIDR(v, "cidr")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SES receipt filter CIDR with IPv6 enabled: %q", v, errors)
+		}
+	}
+
+	for _, v := range invalid {
+		_, errors := validReceiptFilterCIDR(v, "cidr")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SES receipt filter CIDR with IPv6 enabled", v)
+		}
+	}
+}
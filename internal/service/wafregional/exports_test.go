@@ -0,0 +1,6 @@
+package wafregional
+
+// Exports for use in tests only.
+var (
+	NewMockConn = newMockWAFRegionalConn
+)
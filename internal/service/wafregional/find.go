@@ -1,9 +1,25 @@
 package wafregional
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Maximum amount of time to keep retrying a throttled ListRules page.
+	listRulesThrottleTimeout = 2 * time.Minute
+
+	// Maximum number of ListRules pages read before giving up, guarding against
+	// an endpoint that never stops returning a marker.
+	listRulesMaxPages = 1000
+
+	errCodeThrottlingException = "ThrottlingException"
 )
 
 func FindRegexMatchSetByID(conn *wafregional.WAFRegional, id string) (*waf.RegexMatchSet, error) {
@@ -13,3 +29,43 @@ func FindRegexMatchSetByID(conn *wafregional.WAFRegional, id string) (*waf.Regex
 
 	return result.RegexMatchSet, err
 }
+
+// FindRulesByName returns the summaries of all rules with the given name,
+// ignoring case if caseInsensitive is set.
+// Each page is retried with backoff while WAF throttles the request, resuming
+// from the marker of the last page read.
+func FindRulesByName(conn *wafregional.WAFRegional, name string, caseInsensitive bool) ([]*waf.RuleSummary, error) {
+	var rules []*waf.RuleSummary
+
+	// ListRulesInput does not have a name parameter for filtering
+	input := &waf.ListRulesInput{}
+	for page := 1; ; page++ {
+		if page > listRulesMaxPages {
+			return nil, fmt.Errorf("listing WAF Regional Rules exceeded %d pages", listRulesMaxPages)
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(listRulesThrottleTimeout, func() (interface{}, error) {
+			return conn.ListRules(input)
+		}, errCodeThrottlingException)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output := outputRaw.(*waf.ListRulesOutput)
+
+		for _, rule := range output.Rules {
+			if v := aws.StringValue(rule.Name); v == name || (caseInsensitive && strings.EqualFold(v, name)) {
+				rules = append(rules, rule)
+			}
+		}
+
+		// Some endpoints return an empty marker rather than none on the last page.
+		if aws.StringValue(output.NextMarker) == "" {
+			break
+		}
+		input.NextMarker = output.NextMarker
+	}
+
+	return rules, nil
+}
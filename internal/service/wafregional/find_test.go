@@ -0,0 +1,192 @@
+package wafregional
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+)
+
+func TestFindRulesByName(t *testing.T) {
+	var markers []string
+	var throttled bool
+	conn := newMockWAFRegionalConn(t, func(r *request.Request) {
+		marker := aws.StringValue(r.Params.(*waf.ListRulesInput).NextMarker)
+		markers = append(markers, marker)
+		data := r.Data.(*waf.ListRulesOutput)
+
+		switch marker {
+		case "":
+			data.Rules = []*waf.RuleSummary{
+				{Name: aws.String("rule1"), RuleId: aws.String("id1")},
+			}
+			data.NextMarker = aws.String("page2")
+		case "page2":
+			if !throttled {
+				throttled = true
+				r.Error = awserr.New(errCodeThrottlingException, "Rate exceeded", nil)
+				return
+			}
+
+			data.Rules = []*waf.RuleSummary{
+				{Name: aws.String("rule2"), RuleId: aws.String("id2")},
+				{Name: aws.String("rule1"), RuleId: aws.String("id3")},
+			}
+		default:
+			t.Errorf("unexpected marker: %s", marker)
+		}
+	})
+
+	rules, err := FindRulesByName(conn, "rule1", false)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(rules), 2; got != expected {
+		t.Fatalf("got %d rules, expected %d", got, expected)
+	}
+
+	for i, expected := range []string{"id1", "id3"} {
+		if got := aws.StringValue(rules[i].RuleId); got != expected {
+			t.Errorf("got rule ID %s at index %d, expected %s", got, i, expected)
+		}
+	}
+
+	if expected := []string{"", "page2", "page2"}; !reflect.DeepEqual(markers, expected) {
+		t.Errorf("got ListRules markers %q, expected %q", markers, expected)
+	}
+}
+
+func TestFindRulesByName_caseInsensitive(t *testing.T) {
+	conn := newMockWAFRegionalConn(t, func(r *request.Request) {
+		r.Data.(*waf.ListRulesOutput).Rules = []*waf.RuleSummary{
+			{Name: aws.String("Rule1"), RuleId: aws.String("id1")},
+			{Name: aws.String("rule2"), RuleId: aws.String("id2")},
+			{Name: aws.String("RULE1"), RuleId: aws.String("id3")},
+		}
+	})
+
+	testCases := []struct {
+		Name            string
+		CaseInsensitive bool
+		ExpectedIDs     []string
+	}{
+		{
+			Name:        "exact",
+			ExpectedIDs: []string{"id1"},
+		},
+		{
+			Name:            "case insensitive",
+			CaseInsensitive: true,
+			ExpectedIDs:     []string{"id1", "id3"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			rules, err := FindRulesByName(conn, "Rule1", testCase.CaseInsensitive)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var ids []string
+			for _, rule := range rules {
+				ids = append(ids, aws.StringValue(rule.RuleId))
+			}
+
+			if !reflect.DeepEqual(ids, testCase.ExpectedIDs) {
+				t.Errorf("got rule IDs %q, expected %q", ids, testCase.ExpectedIDs)
+			}
+		})
+	}
+}
+
+func TestFindRulesByName_emptyNextMarker(t *testing.T) {
+	var listCalls int
+	conn := newMockWAFRegionalConn(t, func(r *request.Request) {
+		listCalls++
+
+		if listCalls > 2 {
+			t.Fatalf("unexpected ListRules call %d with marker %q", listCalls, aws.StringValue(r.Params.(*waf.ListRulesInput).NextMarker))
+		}
+
+		data := r.Data.(*waf.ListRulesOutput)
+
+		switch marker := aws.StringValue(r.Params.(*waf.ListRulesInput).NextMarker); marker {
+		case "":
+			data.Rules = []*waf.RuleSummary{
+				{Name: aws.String("rule1"), RuleId: aws.String("id1")},
+			}
+			data.NextMarker = aws.String("page2")
+		case "page2":
+			data.Rules = []*waf.RuleSummary{
+				{Name: aws.String("rule2"), RuleId: aws.String("id2")},
+			}
+			data.NextMarker = aws.String("")
+		}
+	})
+
+	rules, err := FindRulesByName(conn, "rule2", false)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(rules), 1; got != expected {
+		t.Fatalf("got %d rules, expected %d", got, expected)
+	}
+
+	if listCalls != 2 {
+		t.Errorf("got %d ListRules calls, expected 2", listCalls)
+	}
+}
+
+func TestFindRulesByName_maxPages(t *testing.T) {
+	var pages int
+	conn := newMockWAFRegionalConn(t, func(r *request.Request) {
+		pages++
+
+		// A pager that never ends.
+		r.Data.(*waf.ListRulesOutput).NextMarker = aws.String(fmt.Sprintf("page%d", pages+1))
+	})
+
+	_, err := FindRulesByName(conn, "rule1", false)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if !strings.Contains(err.Error(), "exceeded") {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if pages != listRulesMaxPages {
+		t.Errorf("got %d ListRules calls, expected %d", pages, listRulesMaxPages)
+	}
+}
+
+// newMockWAFRegionalConn returns a WAF Regional client that hands every request to send instead
+// of calling AWS. send fills in the request's output or sets its error.
+func newMockWAFRegionalConn(t *testing.T, send func(r *request.Request)) *wafregional.WAFRegional {
+	t.Helper()
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := wafregional.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(send)
+
+	return conn
+}
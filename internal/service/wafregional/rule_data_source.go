@@ -2,11 +2,13 @@ package wafregional
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func DataSourceRule() *schema.Resource {
@@ -14,36 +16,54 @@ func DataSourceRule() *schema.Resource {
 		Read: dataSourceRuleRead,
 
 		Schema: map[string]*schema.Schema{
+			"case_insensitive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"predicate": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"negated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tftags.TagsSchemaComputed(),
 		},
 	}
 }
 
 func dataSourceRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).WAFRegionalConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 	name := d.Get("name").(string)
+	caseInsensitive := d.Get("case_insensitive").(bool)
 
-	rules := make([]*waf.RuleSummary, 0)
-	// ListRulesInput does not have a name parameter for filtering
-	input := &waf.ListRulesInput{}
-	for {
-		output, err := conn.ListRules(input)
-		if err != nil {
-			return fmt.Errorf("error reading WAF Rule: %w", err)
-		}
-		for _, rule := range output.Rules {
-			if aws.StringValue(rule.Name) == name {
-				rules = append(rules, rule)
-			}
-		}
+	rules, err := FindRulesByName(conn, name, caseInsensitive)
 
-		if output.NextMarker == nil {
-			break
-		}
-		input.NextMarker = output.NextMarker
+	if err != nil {
+		return fmt.Errorf("error reading WAF Rule: %w", err)
 	}
 
 	if len(rules) == 0 {
@@ -51,12 +71,56 @@ func dataSourceRuleRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(rules) > 1 {
+		if caseInsensitive {
+			var names []string
+
+			for _, rule := range rules {
+				names = append(names, aws.StringValue(rule.Name))
+			}
+
+			return fmt.Errorf("multiple WAF Rules found for name ignoring case: %s (%s), use a name with the exact casing and set case_insensitive to false", name, strings.Join(names, ", "))
+		}
+
 		return fmt.Errorf("multiple WAF Rules found for name: %s", name)
 	}
 
 	rule := rules[0]
 
+	output, err := conn.GetRule(&waf.GetRuleInput{
+		RuleId: rule.RuleId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading WAF Rule (%s): %w", aws.StringValue(rule.RuleId), err)
+	}
+
+	if output == nil || output.Rule == nil {
+		return fmt.Errorf("error reading WAF Rule (%s): empty response", aws.StringValue(rule.RuleId))
+	}
+
 	d.SetId(aws.StringValue(rule.RuleId))
+	d.Set("metric_name", output.Rule.MetricName)
+	d.Set("name", rule.Name)
+
+	if err := d.Set("predicate", flattenWafPredicates(output.Rule.Predicates)); err != nil {
+		return fmt.Errorf("error setting predicate: %w", err)
+	}
+
+	arn, err := meta.(*conns.AWSClient).RegionalARN("waf-regional", fmt.Sprintf("rule/%s", d.Id()))
+
+	if err != nil {
+		return fmt.Errorf("error reading WAF Regional Rule (%s): %w", d.Id(), err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for WAF Regional Rule (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
 
 	return nil
 }
@@ -3,14 +3,109 @@ package wafregional_test
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwafregional "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 )
 
+func TestRuleDataSourceRead_caseInsensitive(t *testing.T) {
+	var rules []*waf.RuleSummary
+	conn := tfwafregional.NewMockConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *waf.ListRulesOutput:
+			data.Rules = rules
+		case *waf.GetRuleOutput:
+			data.Rule = &waf.Rule{
+				MetricName: aws.String("Test"),
+				RuleId:     r.Params.(*waf.GetRuleInput).RuleId,
+			}
+		case *waf.ListTagsForResourceOutput:
+			data.TagInfoForResource = &waf.TagInfoForResource{}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{
+		AccountID:       "123456789012",
+		Partition:       "aws",
+		Region:          "us-west-2",
+		WAFRegionalConn: conn,
+	}
+
+	testCases := []struct {
+		Name            string
+		CaseInsensitive bool
+		Rules           []*waf.RuleSummary
+		ExpectedID      string
+		ExpectedError   *regexp.Regexp
+	}{
+		{
+			Name: "case sensitive",
+			Rules: []*waf.RuleSummary{
+				{Name: aws.String("TestRule"), RuleId: aws.String("id1")},
+			},
+			ExpectedError: regexp.MustCompile(`WAF Rule not found for name: testrule`),
+		},
+		{
+			Name:            "case insensitive",
+			CaseInsensitive: true,
+			Rules: []*waf.RuleSummary{
+				{Name: aws.String("TestRule"), RuleId: aws.String("id1")},
+				{Name: aws.String("other"), RuleId: aws.String("id2")},
+			},
+			ExpectedID: "id1",
+		},
+		{
+			Name:            "case insensitive multiple",
+			CaseInsensitive: true,
+			Rules: []*waf.RuleSummary{
+				{Name: aws.String("TestRule"), RuleId: aws.String("id1")},
+				{Name: aws.String("testRule"), RuleId: aws.String("id2")},
+			},
+			ExpectedError: regexp.MustCompile(`multiple WAF Rules found for name ignoring case: testrule \(TestRule, testRule\)`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			rules = testCase.Rules
+
+			r := tfwafregional.DataSourceRule()
+			d := r.TestResourceData()
+			d.Set("name", "testrule")
+			d.Set("case_insensitive", testCase.CaseInsensitive)
+
+			err := r.Read(d, meta)
+
+			if testCase.ExpectedError != nil {
+				if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Id(); got != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
+
 func TestAccWAFRegionalRuleDataSource_basic(t *testing.T) {
 	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafregional_rule.wafrule"
@@ -29,7 +124,36 @@ func TestAccWAFRegionalRuleDataSource_basic(t *testing.T) {
 				Config: testAccRuleDataSourceConfig_Name(name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "name", name),
 					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "metric_name", resourceName, "metric_name"),
+					resource.TestCheckResourceAttr(datasourceName, "predicate.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "predicate.0.data_id", "aws_wafregional_ipset.ipset", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "predicate.0.negated", "false"),
+					resource.TestCheckResourceAttr(datasourceName, "predicate.0.type", "IPMatch"),
+					resource.TestCheckResourceAttr(datasourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "tags.Name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWAFRegionalRuleDataSource_caseInsensitive(t *testing.T) {
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafregional_rule.wafrule"
+	datasourceName := "data.aws_wafregional_rule.wafrule"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, wafregional.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleDataSourceConfig_CaseInsensitive(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "metric_name", resourceName, "metric_name"),
 				),
 			},
 		},
@@ -38,9 +162,28 @@ func TestAccWAFRegionalRuleDataSource_basic(t *testing.T) {
 
 func testAccRuleDataSourceConfig_Name(name string) string {
 	return fmt.Sprintf(`
+resource "aws_wafregional_ipset" "ipset" {
+  name = %[1]q
+
+  ip_set_descriptor {
+    type  = "IPV4"
+    value = "192.0.7.0/24"
+  }
+}
+
 resource "aws_wafregional_rule" "wafrule" {
   name        = %[1]q
   metric_name = "WafruleTest"
+
+  predicate {
+    data_id = aws_wafregional_ipset.ipset.id
+    negated = false
+    type    = "IPMatch"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
 data "aws_wafregional_rule" "wafrule" {
@@ -54,3 +197,19 @@ data "aws_wafregional_rule" "wafrule" {
   name = "tf-acc-test-does-not-exist"
 }
 `
+
+func testAccRuleDataSourceConfig_CaseInsensitive(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_rule" "wafrule" {
+  name        = %[1]q
+  metric_name = "WafruleTest"
+}
+
+data "aws_wafregional_rule" "wafrule" {
+  name             = %[2]q
+  case_insensitive = true
+
+  depends_on = [aws_wafregional_rule.wafrule]
+}
+`, name, strings.ToUpper(name))
+}
@@ -0,0 +1,6 @@
+package wafv2
+
+// Exports for use in tests only.
+var (
+	NewMockConn = newMockWAFV2Conn
+)
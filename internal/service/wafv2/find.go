@@ -0,0 +1,42 @@
+package wafv2
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// FindWebACLAssociation returns the Web ACL associated with the specified resource,
+// or a resource.NotFoundError if the resource is not associated with the specified Web ACL.
+func FindWebACLAssociation(conn *wafv2.WAFV2, webACLARN, resourceARN string) (*wafv2.WebACL, error) {
+	input := &wafv2.GetWebACLForResourceInput{
+		ResourceArn: aws.String(resourceARN),
+	}
+
+	output, err := conn.GetWebACLForResource(input)
+
+	if tfawserr.ErrCodeEquals(err, wafv2.ErrCodeWAFNonexistentItemException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WebACL == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if aws.StringValue(output.WebACL.ARN) != webACLARN {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output.WebACL, nil
+}
@@ -0,0 +1,75 @@
+package wafv2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+// webACLAssociationResourceTypes lists the ARN service, description and the
+// Web ACL scope required by each resource type that can be associated with a
+// WAFv2 Web ACL. CloudFront distributions reference their Web ACL directly
+// instead of being associated with it.
+var webACLAssociationResourceTypes = []struct {
+	service     string
+	description string
+	scope       string
+}{
+	{"elasticloadbalancing", "Application Load Balancer", wafv2.ScopeRegional},
+	{"apigateway", "API Gateway stage", wafv2.ScopeRegional},
+	{"appsync", "AppSync GraphQL API", wafv2.ScopeRegional},
+	{"cognito-idp", "Cognito user pool", wafv2.ScopeRegional},
+	{"apprunner", "App Runner service", wafv2.ScopeRegional},
+}
+
+// webACLAssociationScope returns the Web ACL scope required by the resource
+// with the given ARN, along with a description of its resource type.
+func webACLAssociationScope(resourceARN string) (string, string, error) {
+	parsedARN, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing WAFv2 Web ACL Association resource ARN (%s): %w", resourceARN, err)
+	}
+
+	for _, resourceType := range webACLAssociationResourceTypes {
+		if parsedARN.Service == resourceType.service {
+			return resourceType.scope, resourceType.description, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("WAFv2 Web ACL Association resource ARN (%s) has unsupported service %q", resourceARN, parsedARN.Service)
+}
+
+func validWebACLAssociationResourceARN(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, value, err))
+		return
+	}
+
+	supported := make([]string, 0, len(webACLAssociationResourceTypes))
+	for _, resourceType := range webACLAssociationResourceTypes {
+		if parsedARN.Service == resourceType.service {
+			return
+		}
+
+		supported = append(supported, fmt.Sprintf("%s (%s)", resourceType.description, resourceType.service))
+	}
+
+	errors = append(errors, fmt.Errorf("%q (%s) has unsupported service %q, must be the ARN of one of: %s", k, value, parsedARN.Service, strings.Join(supported, ", ")))
+
+	return
+}
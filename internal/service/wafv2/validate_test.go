@@ -0,0 +1,35 @@
+package wafv2
+
+import (
+	"testing"
+)
+
+func TestValidWebACLAssociationResourceARN(t *testing.T) {
+	validARNs := []string{
+		"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:apigateway:us-west-2::/restapis/a1b2c3d4e5/stages/prod",                                         //lintignore:AWSAT003,AWSAT005
+		"arn:aws:appsync:us-west-2:123456789012:apis/abcdefghijklmnopqrstuvwxyz",                                 //lintignore:AWSAT003,AWSAT005
+		"arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_aBcDeFgHi",                                //lintignore:AWSAT003,AWSAT005
+		"arn:aws:apprunner:us-west-2:123456789012:service/example/8fe1e10304f84fd2b0df550fe98a71fa",              //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:elasticloadbalancing:us-gov-west-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validWebACLAssociationResourceARN(v, "resource_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid WAFv2 Web ACL Association resource ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"not-an-arn",
+		"arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5",       //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0",     //lintignore:AWSAT003,AWSAT005
+		"arn:aws:wafv2:us-west-2:123456789012:regional/webacl/example/abc123", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validWebACLAssociationResourceARN(v, "resource_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid WAFv2 Web ACL Association resource ARN", v)
+		}
+	}
+}
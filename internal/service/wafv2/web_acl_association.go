@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -18,6 +19,7 @@ import (
 
 const (
 	Wafv2WebACLAssociationCreateTimeout = 5 * time.Minute
+	Wafv2WebACLAssociationDeleteTimeout = 5 * time.Minute
 )
 
 func ResourceWebACLAssociation() *schema.Resource {
@@ -37,12 +39,16 @@ func ResourceWebACLAssociation() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(Wafv2WebACLAssociationDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"resource_arn": {
 				Type:         schema.TypeString,
 				ForceNew:     true,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: validWebACLAssociationResourceARN,
 			},
 			"web_acl_arn": {
 				Type:         schema.TypeString,
@@ -50,6 +56,34 @@ func ResourceWebACLAssociation() *schema.Resource {
 				Required:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"visibility_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sampled_requests_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"web_acl_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_acl_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -63,6 +97,15 @@ func resourceWebACLAssociationCreate(d *schema.ResourceData, meta interface{}) e
 		WebACLArn:   aws.String(webAclArn),
 	}
 
+	if err := checkWebACLAssociationScope(conn, webAclArn, resourceArn); err != nil {
+		return err
+	}
+
+	// Serialize changes to the associations of a single Web ACL so that many
+	// associations created together, e.g. with count, don't race each other.
+	conns.GlobalMutexKV.Lock(webAclArn)
+	defer conns.GlobalMutexKV.Unlock(webAclArn)
+
 	err := resource.Retry(Wafv2WebACLAssociationCreateTimeout, func() *resource.RetryError {
 		_, err := conn.AssociateWebACL(params)
 		if err != nil {
@@ -107,6 +150,20 @@ func resourceWebACLAssociationRead(d *schema.ResourceData, meta interface{}) err
 	if resp == nil || resp.WebACL == nil {
 		log.Printf("[WARN] WAFv2 Web ACL associated resource (%s) not found, removing from state", resourceArn)
 		d.SetId("")
+		return nil
+	}
+
+	webAclName, webAclID, err := webACLNameAndIDFromARN(webAclArn)
+	if err != nil {
+		return err
+	}
+
+	d.Set("web_acl_id", webAclID)
+	d.Set("web_acl_name", webAclName)
+
+	// The associated Web ACL is returned in full, as by GetWebACL.
+	if err := d.Set("visibility_config", flattenVisibilityConfig(resp.WebACL.VisibilityConfig)); err != nil {
+		return fmt.Errorf("error setting visibility_config: %w", err)
 	}
 
 	return nil
@@ -117,18 +174,114 @@ func resourceWebACLAssociationDelete(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] Deleting WAFv2 Web ACL Association %s", d.Id())
 
+	resourceArn := d.Get("resource_arn").(string)
+	webAclArn := d.Get("web_acl_arn").(string)
 	params := &wafv2.DisassociateWebACLInput{
-		ResourceArn: aws.String(d.Get("resource_arn").(string)),
+		ResourceArn: aws.String(resourceArn),
 	}
 
+	conns.GlobalMutexKV.Lock(webAclArn)
 	_, err := conn.DisassociateWebACL(params)
+	conns.GlobalMutexKV.Unlock(webAclArn)
+
 	if err != nil {
 		return fmt.Errorf("Error disassociating WAFv2 Web ACL: %s", err)
 	}
 
+	// Wait until the association is no longer reported so that it can be
+	// immediately recreated, e.g. when the resource is replaced.
+	_, err = tfresource.RetryUntilNotFound(d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return FindWebACLAssociation(conn, webAclArn, resourceArn)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error waiting for WAFv2 Web ACL Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// checkWebACLAssociationScope returns a descriptive error if the Web ACL is not
+// in the scope required by the resource type of resourceARN. The scope encoded
+// in the Web ACL ARN is checked first, then the Web ACL is read in the required
+// scope, as AssociateWebACL only reports that it cannot find the Web ACL.
+func checkWebACLAssociationScope(conn *wafv2.WAFV2, webACLARN, resourceARN string) error {
+	scope, description, err := webACLAssociationScope(resourceARN)
+
+	if err != nil {
+		return err
+	}
+
+	name, id, err := webACLNameAndIDFromARN(webACLARN)
+
+	if err != nil {
+		return err
+	}
+
+	webACLScope, err := webACLScopeFromARN(webACLARN)
+
+	if err != nil {
+		return err
+	}
+
+	if webACLScope != scope {
+		return fmt.Errorf("WAFv2 Web ACL (%s) has scope %s, but %s (%s) can only be associated with a Web ACL with scope %s", webACLARN, webACLScope, description, resourceARN, scope)
+	}
+
+	_, err = conn.GetWebACL(&wafv2.GetWebACLInput{
+		Id:    aws.String(id),
+		Name:  aws.String(name),
+		Scope: aws.String(scope),
+	})
+
+	if tfawserr.ErrCodeEquals(err, wafv2.ErrCodeWAFNonexistentItemException) {
+		return fmt.Errorf("WAFv2 Web ACL (%s) not found with scope %s in the provider region, which %s (%s) requires", webACLARN, scope, description, resourceARN)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WAFv2 Web ACL (%s): %w", webACLARN, err)
+	}
+
 	return nil
 }
 
+// webACLScopeFromARN returns the scope (REGIONAL or CLOUDFRONT) of a Web ACL
+// from its ARN, in which CLOUDFRONT Web ACLs have a global prefix.
+func webACLScopeFromARN(s string) (string, error) {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing WAFv2 Web ACL ARN (%s): %w", s, err)
+	}
+
+	switch prefix := strings.Split(parsedARN.Resource, "/")[0]; prefix {
+	case "regional":
+		return wafv2.ScopeRegional, nil
+	case "global":
+		return wafv2.ScopeCloudfront, nil
+	default:
+		return "", fmt.Errorf("unexpected scope %q in WAFv2 Web ACL ARN (%s), expected regional or global", prefix, s)
+	}
+}
+
+// webACLNameAndIDFromARN parses the name and ID of a Web ACL from its ARN,
+// e.g. arn:aws:wafv2:us-west-2:123456789012:regional/webacl/NAME/ID.
+func webACLNameAndIDFromARN(s string) (string, string, error) {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing WAFv2 Web ACL ARN (%s): %w", s, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if parsedARN.Service != wafv2.EndpointsID || len(parts) != 4 || parts[1] != "webacl" || parts[2] == "" || parts[3] == "" {
+		return "", "", fmt.Errorf("unexpected format of WAFv2 Web ACL ARN (%s), expected arn:PARTITION:wafv2:REGION:ACCOUNT:SCOPE/webacl/NAME/ID", s)
+	}
+
+	return parts[2], parts[3], nil
+}
+
 func resourceACLAssociationDecodeID(id string) (string, string, error) {
 	parts := strings.SplitN(id, ",", 2)
 
@@ -0,0 +1,71 @@
+package wafv2
+
+import (
+	"testing"
+)
+
+func TestWebACLNameAndIDFromARN(t *testing.T) {
+	testCases := []struct {
+		ARN          string
+		ExpectedName string
+		ExpectedID   string
+		ExpectError  bool
+	}{
+		{
+			ARN:          "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111", //lintignore:AWSAT003,AWSAT005
+			ExpectedName: "example",
+			ExpectedID:   "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+		},
+		{
+			ARN:          "arn:aws-us-gov:wafv2:us-gov-west-1:123456789012:regional/webacl/my-web-acl/abc123", //lintignore:AWSAT003,AWSAT005
+			ExpectedName: "my-web-acl",
+			ExpectedID:   "abc123",
+		},
+		{
+			ARN:          "arn:aws:wafv2:us-east-1:123456789012:global/webacl/example/abc123", //lintignore:AWSAT003,AWSAT005
+			ExpectedName: "example",
+			ExpectedID:   "abc123",
+		},
+		{
+			ARN:         "not-an-arn",
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:wafv2:us-west-2:123456789012:regional/ipset/example/abc123", //lintignore:AWSAT003,AWSAT005
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/example", //lintignore:AWSAT003,AWSAT005
+			ExpectError: true,
+		},
+		{
+			ARN:         "arn:aws:waf-regional:us-west-2:123456789012:webacl/abc123", //lintignore:AWSAT003,AWSAT005
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		name, id, err := webACLNameAndIDFromARN(testCase.ARN)
+
+		if testCase.ExpectError {
+			if err == nil {
+				t.Errorf("%q: expected error, got name %q and ID %q", testCase.ARN, name, id)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", testCase.ARN, err)
+			continue
+		}
+
+		if name != testCase.ExpectedName {
+			t.Errorf("%q: got name %q, expected %q", testCase.ARN, name, testCase.ExpectedName)
+		}
+
+		if id != testCase.ExpectedID {
+			t.Errorf("%q: got ID %q, expected %q", testCase.ARN, id, testCase.ExpectedID)
+		}
+	}
+}
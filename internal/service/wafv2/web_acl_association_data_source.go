@@ -0,0 +1,92 @@
+package wafv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceWebACLAssociation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceWebACLAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"log_destination_configs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"logging_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validWebACLAssociationResourceARN,
+			},
+			"web_acl_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_acl_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_acl_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceWebACLAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WAFV2Conn
+	resourceARN := d.Get("resource_arn").(string)
+
+	output, err := conn.GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
+		ResourceArn: aws.String(resourceARN),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading WAFv2 Web ACL for resource (%s): %w", resourceARN, err)
+	}
+
+	if output == nil || output.WebACL == nil {
+		return fmt.Errorf("no WAFv2 Web ACL associated with resource (%s)", resourceARN)
+	}
+
+	webACLARN := aws.StringValue(output.WebACL.ARN)
+
+	// A Web ACL without a logging configuration is reported as not found.
+	loggingOutput, err := conn.GetLoggingConfiguration(&wafv2.GetLoggingConfigurationInput{
+		ResourceArn: aws.String(webACLARN),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, wafv2.ErrCodeWAFNonexistentItemException) {
+		return fmt.Errorf("error reading WAFv2 Logging Configuration for Web ACL (%s): %w", webACLARN, err)
+	}
+
+	var logDestinationConfigs []string
+
+	if loggingOutput != nil && loggingOutput.LoggingConfiguration != nil {
+		logDestinationConfigs = aws.StringValueSlice(loggingOutput.LoggingConfiguration.LogDestinationConfigs)
+	}
+
+	d.SetId(resourceARN)
+	d.Set("logging_enabled", len(logDestinationConfigs) > 0)
+	d.Set("web_acl_arn", webACLARN)
+	d.Set("web_acl_id", output.WebACL.Id)
+	d.Set("web_acl_name", output.WebACL.Name)
+
+	if err := d.Set("log_destination_configs", logDestinationConfigs); err != nil {
+		return fmt.Errorf("error setting log_destination_configs: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package wafv2_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwafv2 "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+)
+
+func TestWebACLAssociationDataSourceRead_loggingConfiguration(t *testing.T) {
+	webACLARN := "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/test/11111111-1111-1111-1111-111111111111" //lintignore:AWSAT003,AWSAT005
+	resourceARN := "arn:aws:apigateway:us-west-2::/restapis/test/stages/test"                                     //lintignore:AWSAT003,AWSAT005
+	destinationARN := "arn:aws:firehose:us-west-2:123456789012:deliverystream/aws-waf-logs-test"                  //lintignore:AWSAT003,AWSAT005
+
+	testCases := []struct {
+		Name                          string
+		LoggingConfiguration          *wafv2.LoggingConfiguration
+		ExpectedLoggingEnabled        bool
+		ExpectedLogDestinationConfigs []interface{}
+	}{
+		{
+			Name: "logging enabled",
+			LoggingConfiguration: &wafv2.LoggingConfiguration{
+				LogDestinationConfigs: aws.StringSlice([]string{destinationARN}),
+				ResourceArn:           aws.String(webACLARN),
+			},
+			ExpectedLoggingEnabled:        true,
+			ExpectedLogDestinationConfigs: []interface{}{destinationARN},
+		},
+		{
+			Name:                          "logging disabled",
+			ExpectedLogDestinationConfigs: []interface{}{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := tfwafv2.NewMockConn(t, func(r *request.Request) {
+				switch data := r.Data.(type) {
+				case *wafv2.GetWebACLForResourceOutput:
+					data.WebACL = &wafv2.WebACL{
+						ARN:  aws.String(webACLARN),
+						Id:   aws.String("11111111-1111-1111-1111-111111111111"),
+						Name: aws.String("test"),
+					}
+				case *wafv2.GetLoggingConfigurationOutput:
+					if got := aws.StringValue(r.Params.(*wafv2.GetLoggingConfigurationInput).ResourceArn); got != webACLARN {
+						t.Errorf("got ResourceArn %s, expected %s", got, webACLARN)
+					}
+
+					if testCase.LoggingConfiguration == nil {
+						r.Error = awserr.New(wafv2.ErrCodeWAFNonexistentItemException, "The referenced item does not exist.", nil)
+						return
+					}
+
+					data.LoggingConfiguration = testCase.LoggingConfiguration
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+
+			r := tfwafv2.DataSourceWebACLAssociation()
+			d := r.TestResourceData()
+			d.Set("resource_arn", resourceARN)
+
+			if err := r.Read(d, &conns.AWSClient{WAFV2Conn: conn}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := d.Get("web_acl_arn").(string), webACLARN; got != want {
+				t.Errorf("got web_acl_arn %s, expected %s", got, want)
+			}
+
+			if got, want := d.Get("web_acl_name").(string), "test"; got != want {
+				t.Errorf("got web_acl_name %s, expected %s", got, want)
+			}
+
+			if got := d.Get("logging_enabled").(bool); got != testCase.ExpectedLoggingEnabled {
+				t.Errorf("got logging_enabled %t, expected %t", got, testCase.ExpectedLoggingEnabled)
+			}
+
+			if got := d.Get("log_destination_configs").([]interface{}); !reflect.DeepEqual(got, testCase.ExpectedLogDestinationConfigs) {
+				t.Errorf("got log_destination_configs %v, expected %v", got, testCase.ExpectedLogDestinationConfigs)
+			}
+		})
+	}
+}
+
+func TestAccWAFV2WebACLAssociationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_wafv2_web_acl_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIGatewayTypeEDGE(t)
+			testAccPreCheckScopeRegional(t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLAssociationDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "web_acl_arn", "aws_wafv2_web_acl.test", "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "web_acl_id", "aws_wafv2_web_acl.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "web_acl_name", "aws_wafv2_web_acl.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "logging_enabled", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "log_destination_configs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLAssociationDataSource_logging(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_wafv2_web_acl_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIGatewayTypeEDGE(t)
+			testAccPreCheckScopeRegional(t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLAssociationDataSourceLoggingConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "web_acl_arn", "aws_wafv2_web_acl.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "logging_enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "log_destination_configs.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "log_destination_configs.0", "aws_kinesis_firehose_delivery_stream.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebACLAssociationDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationConfig(rName), `
+data "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_wafv2_web_acl_association.test.resource_arn
+}
+`)
+}
+
+func testAccWebACLAssociationDataSourceLoggingConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccWebACLLoggingConfiguration_basic(rName),
+		testAccWebACLAssociationAPIGatewayConfig(rName),
+		`
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_api_gateway_stage.test.arn
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+
+data "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_wafv2_web_acl_association.test.resource_arn
+
+  depends_on = [aws_wafv2_web_acl_logging_configuration.test]
+}
+`)
+}
@@ -0,0 +1,142 @@
+package wafv2
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestWebACLAssociationConcurrentCreateDelete(t *testing.T) {
+	webACLARNs := []string{
+		"arn:aws:wafv2:us-west-2:123456789012:regional/webacl/test-1/11111111-1111-1111-1111-111111111111",
+		"arn:aws:wafv2:us-west-2:123456789012:regional/webacl/test-2/22222222-2222-2222-2222-222222222222",
+	}
+
+	var mu sync.Mutex
+	associations := map[string]string{}
+	inFlight := map[string]int{}
+	maxInFlight := map[string]int{}
+
+	// track records a call changing the associations of a Web ACL and
+	// returns a function that marks the call as complete.
+	track := func(webACLARN string) func() {
+		mu.Lock()
+		inFlight[webACLARN]++
+		if inFlight[webACLARN] > maxInFlight[webACLARN] {
+			maxInFlight[webACLARN] = inFlight[webACLARN]
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		return func() {
+			mu.Lock()
+			inFlight[webACLARN]--
+			mu.Unlock()
+		}
+	}
+
+	conn := newMockWAFV2Conn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *wafv2.AssociateWebACLOutput:
+			input := r.Params.(*wafv2.AssociateWebACLInput)
+			webACLARN := aws.StringValue(input.WebACLArn)
+
+			defer track(webACLARN)()
+
+			mu.Lock()
+			associations[aws.StringValue(input.ResourceArn)] = webACLARN
+			mu.Unlock()
+		case *wafv2.DisassociateWebACLOutput:
+			resourceARN := aws.StringValue(r.Params.(*wafv2.DisassociateWebACLInput).ResourceArn)
+
+			mu.Lock()
+			webACLARN := associations[resourceARN]
+			mu.Unlock()
+
+			defer track(webACLARN)()
+
+			mu.Lock()
+			delete(associations, resourceARN)
+			mu.Unlock()
+		case *wafv2.GetWebACLOutput:
+		case *wafv2.GetWebACLForResourceOutput:
+			mu.Lock()
+			webACLARN, ok := associations[aws.StringValue(r.Params.(*wafv2.GetWebACLForResourceInput).ResourceArn)]
+			mu.Unlock()
+
+			if ok {
+				data.WebACL = &wafv2.WebACL{ARN: aws.String(webACLARN)}
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	meta := &conns.AWSClient{WAFV2Conn: conn}
+	r := ResourceWebACLAssociation()
+
+	var resourceData []*schema.ResourceData
+
+	for i := 0; i < 5; i++ {
+		for _, webACLARN := range webACLARNs {
+			resourceData = append(resourceData, schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"resource_arn": fmt.Sprintf("arn:aws:apigateway:us-west-2::/restapis/test%d/stages/%s", i, webACLARN[len(webACLARN)-4:]),
+				"web_acl_arn":  webACLARN,
+			}))
+		}
+	}
+
+	run := func(f func(*schema.ResourceData, interface{}) error) {
+		var wg sync.WaitGroup
+		errs := make(chan error, len(resourceData))
+
+		for _, d := range resourceData {
+			wg.Add(1)
+			go func(d *schema.ResourceData) {
+				defer wg.Done()
+				errs <- f(d, meta)
+			}(d)
+		}
+
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+	}
+
+	run(resourceWebACLAssociationCreate)
+
+	if got, expected := len(associations), len(resourceData); got != expected {
+		t.Fatalf("expected %d associations, got %d", expected, got)
+	}
+
+	for _, d := range resourceData {
+		if d.Id() == "" {
+			t.Errorf("expected ID to be set for association with %s", d.Get("resource_arn"))
+		}
+	}
+
+	run(resourceWebACLAssociationDelete)
+
+	if got := len(associations); got != 0 {
+		t.Fatalf("expected no associations, got %d", got)
+	}
+
+	for _, webACLARN := range webACLARNs {
+		if got := maxInFlight[webACLARN]; got != 1 {
+			t.Errorf("expected association changes to %s to be serialized, got %d concurrent calls", webACLARN, got)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package wafv2
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+func TestWebACLAssociationScope(t *testing.T) {
+	testCases := []struct {
+		ARN                 string
+		ExpectedScope       string
+		ExpectedDescription string
+		ExpectError         bool
+	}{
+		{
+			ARN:                 "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+			ExpectedScope:       wafv2.ScopeRegional,
+			ExpectedDescription: "Application Load Balancer",
+		},
+		{
+			ARN:                 "arn:aws:apigateway:us-west-2::/restapis/a1b2c3d4e5/stages/prod", //lintignore:AWSAT003,AWSAT005
+			ExpectedScope:       wafv2.ScopeRegional,
+			ExpectedDescription: "API Gateway stage",
+		},
+		{
+			ARN:                 "arn:aws:appsync:us-west-2:123456789012:apis/abcdefghijklmnopqrstuvwxyz", //lintignore:AWSAT003,AWSAT005
+			ExpectedScope:       wafv2.ScopeRegional,
+			ExpectedDescription: "AppSync GraphQL API",
+		},
+		{
+			ARN:                 "arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_aBcDeFgHi", //lintignore:AWSAT003,AWSAT005
+			ExpectedScope:       wafv2.ScopeRegional,
+			ExpectedDescription: "Cognito user pool",
+		},
+		{
+			ARN:                 "arn:aws:apprunner:us-west-2:123456789012:service/example/8fe1e10304f84fd2b0df550fe98a71fa", //lintignore:AWSAT003,AWSAT005
+			ExpectedScope:       wafv2.ScopeRegional,
+			ExpectedDescription: "App Runner service",
+		},
+		{
+			ARN:         "arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5", //lintignore:AWSAT003,AWSAT005
+			ExpectError: true,
+		},
+		{
+			ARN:         "not-an-arn",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		scope, description, err := webACLAssociationScope(testCase.ARN)
+
+		if testCase.ExpectError {
+			if err == nil {
+				t.Errorf("%q: expected error, got scope %q", testCase.ARN, scope)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", testCase.ARN, err)
+			continue
+		}
+
+		if scope != testCase.ExpectedScope {
+			t.Errorf("%q: got scope %q, expected %q", testCase.ARN, scope, testCase.ExpectedScope)
+		}
+
+		if description != testCase.ExpectedDescription {
+			t.Errorf("%q: got description %q, expected %q", testCase.ARN, description, testCase.ExpectedDescription)
+		}
+	}
+}
+
+func TestCheckWebACLAssociationScope(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		WebACLARN     string
+		ResourceARN   string
+		NotFound      bool
+		ExpectGet     bool
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:        "regional load balancer",
+			WebACLARN:   "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/test/11111111-1111-1111-1111-111111111111",         //lintignore:AWSAT003,AWSAT005
+			ResourceARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+			ExpectGet:   true,
+		},
+		{
+			Name:        "regional API Gateway stage",
+			WebACLARN:   "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/test/11111111-1111-1111-1111-111111111111", //lintignore:AWSAT003,AWSAT005
+			ResourceARN: "arn:aws:apigateway:us-west-2::/restapis/a1b2c3d4e5/stages/prod",                                 //lintignore:AWSAT003,AWSAT005
+			ExpectGet:   true,
+		},
+		{
+			Name:          "global Cognito user pool",
+			WebACLARN:     "arn:aws:wafv2:us-east-1:123456789012:global/webacl/test/11111111-1111-1111-1111-111111111111", //lintignore:AWSAT003,AWSAT005
+			ResourceARN:   "arn:aws:cognito-idp:us-east-1:123456789012:userpool/us-east-1_aBcDeFgHi",                      //lintignore:AWSAT003,AWSAT005
+			ExpectedError: regexp.MustCompile(`has scope CLOUDFRONT, but Cognito user pool \(.+\) can only be associated with a Web ACL with scope REGIONAL`),
+		},
+		{
+			Name:          "global AppSync GraphQL API",
+			WebACLARN:     "arn:aws:wafv2:us-east-1:123456789012:global/webacl/test/11111111-1111-1111-1111-111111111111", //lintignore:AWSAT003,AWSAT005
+			ResourceARN:   "arn:aws:appsync:us-east-1:123456789012:apis/abcdefghijklmnopqrstuvwxyz",                       //lintignore:AWSAT003,AWSAT005
+			ExpectedError: regexp.MustCompile(`has scope CLOUDFRONT, but AppSync GraphQL API \(.+\) can only be associated`),
+		},
+		{
+			Name:          "not found in scope",
+			WebACLARN:     "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/test/11111111-1111-1111-1111-111111111111", //lintignore:AWSAT003,AWSAT005
+			ResourceARN:   "arn:aws:apprunner:us-west-2:123456789012:service/example/8fe1e10304f84fd2b0df550fe98a71fa",      //lintignore:AWSAT003,AWSAT005
+			NotFound:      true,
+			ExpectGet:     true,
+			ExpectedError: regexp.MustCompile(`not found with scope REGIONAL in the provider region, which App Runner service \(.+\) requires`),
+		},
+		{
+			Name:          "invalid Web ACL ARN",
+			WebACLARN:     "arn:aws:wafv2:us-west-2:123456789012:regional/ipset/test/11111111-1111-1111-1111-111111111111", //lintignore:AWSAT003,AWSAT005
+			ResourceARN:   "arn:aws:apigateway:us-west-2::/restapis/a1b2c3d4e5/stages/prod",                                //lintignore:AWSAT003,AWSAT005
+			ExpectedError: regexp.MustCompile(`unexpected format of WAFv2 Web ACL ARN`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var getCalled bool
+			conn := newMockWAFV2Conn(t, func(r *request.Request) {
+				switch r.Data.(type) {
+				case *wafv2.GetWebACLOutput:
+					getCalled = true
+					input := r.Params.(*wafv2.GetWebACLInput)
+
+					if got, want := aws.StringValue(input.Scope), wafv2.ScopeRegional; got != want {
+						t.Errorf("got Scope %s, expected %s", got, want)
+					}
+
+					if got, want := aws.StringValue(input.Name), "test"; got != want {
+						t.Errorf("got Name %s, expected %s", got, want)
+					}
+
+					if got, want := aws.StringValue(input.Id), "11111111-1111-1111-1111-111111111111"; got != want {
+						t.Errorf("got Id %s, expected %s", got, want)
+					}
+
+					if testCase.NotFound {
+						r.Error = awserr.New(wafv2.ErrCodeWAFNonexistentItemException, "The referenced item does not exist.", nil)
+					}
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+
+			err := checkWebACLAssociationScope(conn, testCase.WebACLARN, testCase.ResourceARN)
+
+			if getCalled != testCase.ExpectGet {
+				t.Errorf("got GetWebACL called %t, expected %t", getCalled, testCase.ExpectGet)
+			}
+
+			if testCase.ExpectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
+// newMockWAFV2Conn returns a WAFv2 client that hands every request to send instead
+// of calling AWS. send fills in the request's output or sets its error.
+func newMockWAFV2Conn(t *testing.T, send func(r *request.Request)) *wafv2.WAFV2 {
+	t.Helper()
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := wafv2.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(send)
+
+	return conn
+}
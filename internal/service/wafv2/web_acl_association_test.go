@@ -2,10 +2,12 @@ package wafv2_test
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -17,6 +19,55 @@ import (
 	tfwafv2 "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 )
 
+func TestWebACLAssociationRead_visibilityConfig(t *testing.T) {
+	webACLARN := "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/test/11111111-1111-1111-1111-111111111111"
+	resourceARN := "arn:aws:apigateway:us-west-2::/restapis/test/stages/test"
+
+	conn := tfwafv2.NewMockConn(t, func(r *request.Request) {
+		switch data := r.Data.(type) {
+		case *wafv2.GetWebACLForResourceOutput:
+			if got := aws.StringValue(r.Params.(*wafv2.GetWebACLForResourceInput).ResourceArn); got != resourceARN {
+				t.Errorf("got ResourceArn %s, expected %s", got, resourceARN)
+			}
+
+			data.WebACL = &wafv2.WebACL{
+				ARN:  aws.String(webACLARN),
+				Id:   aws.String("11111111-1111-1111-1111-111111111111"),
+				Name: aws.String("test"),
+				VisibilityConfig: &wafv2.VisibilityConfig{
+					CloudWatchMetricsEnabled: aws.Bool(true),
+					MetricName:               aws.String("test-metric"),
+					SampledRequestsEnabled:   aws.Bool(true),
+				},
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	r := tfwafv2.ResourceWebACLAssociation()
+	d := r.TestResourceData()
+	d.SetId(fmt.Sprintf("%s,%s", webACLARN, resourceARN))
+	d.Set("resource_arn", resourceARN)
+	d.Set("web_acl_arn", webACLARN)
+
+	if err := r.Read(d, &conns.AWSClient{WAFV2Conn: conn}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"cloudwatch_metrics_enabled": true,
+			"metric_name":                "test-metric",
+			"sampled_requests_enabled":   true,
+		},
+	}
+
+	if got := d.Get("visibility_config"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got visibility_config %v, expected %v", got, expected)
+	}
+}
+
 func TestAccWAFV2WebACLAssociation_basic(t *testing.T) {
 	testName := fmt.Sprintf("web-acl-association-%s", sdkacctest.RandString(5))
 	resourceName := "aws_wafv2_web_acl_association.test"
@@ -37,6 +88,12 @@ func TestAccWAFV2WebACLAssociation_basic(t *testing.T) {
 					testAccCheckWebACLAssociationExists(resourceName),
 					acctest.MatchResourceAttrRegionalARNNoAccount(resourceName, "resource_arn", "apigateway", regexp.MustCompile(fmt.Sprintf("/restapis/.*/stages/%s", testName))),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "web_acl_arn", "wafv2", regexp.MustCompile(fmt.Sprintf("regional/webacl/%s/.*", testName))),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_id", "aws_wafv2_web_acl.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_name", "aws_wafv2_web_acl.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_config.0.cloudwatch_metrics_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_config.0.metric_name", "friendly-metric-name"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_config.0.sampled_requests_enabled", "false"),
 				),
 			},
 			{
@@ -83,6 +140,67 @@ func TestAccWAFV2WebACLAssociation_disappears(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACLAssociation_replace(t *testing.T) {
+	testName := fmt.Sprintf("web-acl-association-%s", sdkacctest.RandString(5))
+	resourceName := "aws_wafv2_web_acl_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIGatewayTypeEDGE(t)
+			testAccPreCheckScopeRegional(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLAssociationReplaceConfig(testName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_arn", "aws_wafv2_web_acl.test", "arn"),
+				),
+			},
+			{
+				// Replacing the association deletes and recreates it in a single apply.
+				Config: testAccWebACLAssociationReplaceConfig(testName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_arn", "aws_wafv2_web_acl.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLAssociation_multipleAssociations(t *testing.T) {
+	testName := fmt.Sprintf("web-acl-association-%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIGatewayTypeEDGE(t)
+			testAccPreCheckScopeRegional(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLAssociationMultipleConfig(testName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLAssociationExists("aws_wafv2_web_acl_association.multiple.0"),
+					testAccCheckWebACLAssociationExists("aws_wafv2_web_acl_association.multiple.1"),
+					testAccCheckWebACLAssociationExists("aws_wafv2_web_acl_association.multiple.2"),
+					testAccCheckWebACLAssociationExists("aws_wafv2_web_acl_association.multiple.3"),
+					testAccCheckWebACLAssociationExists("aws_wafv2_web_acl_association.multiple.4"),
+					resource.TestCheckResourceAttrPair("aws_wafv2_web_acl_association.multiple.4", "web_acl_arn", "aws_wafv2_web_acl.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckWebACLAssociationDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_wafv2_web_acl_association" {
@@ -127,7 +245,7 @@ func testAccCheckWebACLAssociationExists(name string) resource.TestCheckFunc {
 	}
 }
 
-func testAccWebACLAssociationConfig(name string) string {
+func testAccWebACLAssociationAPIGatewayConfig(name string) string {
 	return fmt.Sprintf(`
 resource "aws_api_gateway_stage" "test" {
   stage_name    = "%s"
@@ -163,7 +281,11 @@ resource "aws_api_gateway_method" "test" {
   http_method   = "GET"
   authorization = "NONE"
 }
+`, name, name)
+}
 
+func testAccWebACLAssociationBaseConfig(name string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationAPIGatewayConfig(name), fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {
   name  = "%s"
   scope = "REGIONAL"
@@ -178,12 +300,59 @@ resource "aws_wafv2_web_acl" "test" {
     sampled_requests_enabled   = false
   }
 }
+`, name))
+}
 
+func testAccWebACLAssociationConfig(name string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationBaseConfig(name), `
 resource "aws_wafv2_web_acl_association" "test" {
   resource_arn = aws_api_gateway_stage.test.arn
   web_acl_arn  = aws_wafv2_web_acl.test.arn
 }
-`, name, name, name)
+`)
+}
+
+func testAccWebACLAssociationReplaceConfig(name, webACLResourceName string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationBaseConfig(name), fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test2" {
+  name  = "%[1]s-2"
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_api_gateway_stage.test.arn
+  web_acl_arn  = aws_wafv2_web_acl.%[2]s.arn
+}
+`, name, webACLResourceName))
+}
+
+func testAccWebACLAssociationMultipleConfig(name string, count int) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationBaseConfig(name), fmt.Sprintf(`
+resource "aws_api_gateway_stage" "multiple" {
+  count = %[2]d
+
+  stage_name    = "%[1]s-${count.index}"
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  deployment_id = aws_api_gateway_deployment.test.id
+}
+
+resource "aws_wafv2_web_acl_association" "multiple" {
+  count = %[2]d
+
+  resource_arn = aws_api_gateway_stage.multiple[count.index].arn
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+`, name, count))
 }
 
 func testAccWebACLAssociationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
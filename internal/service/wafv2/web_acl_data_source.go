@@ -43,39 +43,40 @@ func dataSourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).WAFV2Conn
 	name := d.Get("name").(string)
 
-	var foundWebACL *wafv2.WebACLSummary
+	var webACLs []*wafv2.WebACLSummary
 	input := &wafv2.ListWebACLsInput{
 		Scope: aws.String(d.Get("scope").(string)),
 		Limit: aws.Int64(100),
 	}
 
-	for {
-		resp, err := conn.ListWebACLs(input)
-		if err != nil {
-			return fmt.Errorf("Error reading WAFv2 WebACLs: %w", err)
+	err := listWebACLsPages(conn, input, func(page *wafv2.ListWebACLsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		if resp == nil || resp.WebACLs == nil {
-			return fmt.Errorf("Error reading WAFv2 WebACLs")
-		}
-
-		for _, webACL := range resp.WebACLs {
-			if aws.StringValue(webACL.Name) == name {
-				foundWebACL = webACL
-				break
+		for _, webACL := range page.WebACLs {
+			if webACL != nil && aws.StringValue(webACL.Name) == name {
+				webACLs = append(webACLs, webACL)
 			}
 		}
 
-		if resp.NextMarker == nil || foundWebACL != nil {
-			break
-		}
-		input.NextMarker = resp.NextMarker
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading WAFv2 WebACLs: %w", err)
 	}
 
-	if foundWebACL == nil {
+	if len(webACLs) == 0 {
 		return fmt.Errorf("WAFv2 WebACL not found for name: %s", name)
 	}
 
+	if len(webACLs) > 1 {
+		return fmt.Errorf("multiple WAFv2 WebACLs found for name: %s", name)
+	}
+
+	foundWebACL := webACLs[0]
+
 	d.SetId(aws.StringValue(foundWebACL.Id))
 	d.Set("arn", foundWebACL.ARN)
 	d.Set("description", foundWebACL.Description)
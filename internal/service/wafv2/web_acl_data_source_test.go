@@ -40,6 +40,48 @@ func TestAccWAFV2WebACLDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACLDataSource_association(t *testing.T) {
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+	datasourceName := "data.aws_wafv2_web_acl.test"
+	associationResourceName := "aws_wafv2_web_acl_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIGatewayTypeEDGE(t)
+			testAccPreCheckScopeRegional(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLDataSource_Association(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(associationResourceName, "web_acl_arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebACLDataSource_Association(name string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationBaseConfig(name), `
+data "aws_wafv2_web_acl" "test" {
+  name  = aws_wafv2_web_acl.test.name
+  scope = "REGIONAL"
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_api_gateway_stage.test.arn
+  web_acl_arn  = data.aws_wafv2_web_acl.test.arn
+}
+`)
+}
+
 func testAccWebACLDataSource_Name(name string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {
@@ -0,0 +1,66 @@
+package wafv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceWebACLResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceWebACLResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      wafv2.ResourceTypeApplicationLoadBalancer,
+				ValidateFunc: validation.StringInSlice(wafv2.ResourceType_Values(), false),
+			},
+			"web_acl_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceWebACLResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WAFV2Conn
+	webACLARN := d.Get("web_acl_arn").(string)
+	resourceType := d.Get("resource_type").(string)
+
+	output, err := conn.ListResourcesForWebACL(&wafv2.ListResourcesForWebACLInput{
+		ResourceType: aws.String(resourceType),
+		WebACLArn:    aws.String(webACLARN),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing WAFv2 WebACL (%s) %s resources: %w", webACLARN, resourceType, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", webACLARN, resourceType))
+
+	var resourceARNs []string
+
+	if output != nil {
+		resourceARNs = aws.StringValueSlice(output.ResourceArns)
+	}
+
+	if err := d.Set("resource_arns", resourceARNs); err != nil {
+		return fmt.Errorf("error setting resource_arns: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package wafv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccWAFV2WebACLResourcesDataSource_basic(t *testing.T) {
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	datasourceName := "data.aws_wafv2_web_acl_resources.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAPIGatewayTypeEDGE(t)
+			testAccPreCheckScopeRegional(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLResourcesDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "resource_type", wafv2.ResourceTypeApiGateway),
+					resource.TestCheckResourceAttr(datasourceName, "resource_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "resource_arns.0", "aws_api_gateway_stage.test", "arn"),
+					resource.TestCheckResourceAttr("data.aws_wafv2_web_acl_resources.alb", "resource_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebACLResourcesDataSourceConfig(name string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationConfig(name), `
+data "aws_wafv2_web_acl_resources" "test" {
+  web_acl_arn   = aws_wafv2_web_acl_association.test.web_acl_arn
+  resource_type = "API_GATEWAY"
+}
+
+data "aws_wafv2_web_acl_resources" "alb" {
+  web_acl_arn   = aws_wafv2_web_acl_association.test.web_acl_arn
+  resource_type = "APPLICATION_LOAD_BALANCER"
+}
+`)
+}
@@ -488,6 +488,14 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	return result
 }
 
+// RemoveDefaultConfigExcept returns tags not present in a DefaultConfig object,
+// like RemoveDefaultConfig, but keeps tags whose keys are in the given tags,
+// typically the resource tags already in state. Otherwise a resource tag that
+// shadows a default tag with the same value would show up as a diff on every plan.
+func (tags KeyValueTags) RemoveDefaultConfigExcept(dc *DefaultConfig, except KeyValueTags) KeyValueTags {
+	return tags.RemoveDefaultConfig(dc).Merge(tags.Only(except))
+}
+
 // String returns the default string representation of the KeyValueTags.
 func (tags KeyValueTags) String() string {
 	var builder strings.Builder